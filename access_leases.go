@@ -0,0 +1,377 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	accessLeasesBasePath          = "v2/AccessLease"
+	accessLeaseInvitationBasePath = "v2/AccessLeaseInvitation"
+)
+
+// AccessLeasesService resource has methods to manage dynamic access leases,
+// which temporarily open access to a security group.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-AccessLease
+type AccessLeasesService interface {
+	List(context.Context) (*AccessLeases, *http.Response, error)
+	CreateAws(context.Context, *AwsAccessLease) (*AwsAccessLease, *http.Response, error)
+	CreateAzure(context.Context, *AzureAccessLease) (*AzureAccessLease, *http.Response, error)
+	CreateInvitation(context.Context, *AccessLeaseInvitation) (*AccessLeaseInvitation, *http.Response, error)
+	Terminate(context.Context, string) (*http.Response, error)
+}
+
+// AccessLeasesServiceOp handles communication with the Access Leases
+// related methods of the Dome9 API.
+type AccessLeasesServiceOp struct {
+	client *Client
+}
+
+var _ AccessLeasesService = &AccessLeasesServiceOp{}
+
+// AccessLeases are the active access leases.
+type AccessLeases struct {
+	Aws   []AwsAccessLease   `json:"aws"`
+	Azure []AzureAccessLease `json:"azure"`
+}
+
+// AwsAccessLease opens access from an IP to an AWS security group, on a port
+// range, for Length. An empty IP is the IP of the user creating the lease.
+type AwsAccessLease struct {
+	ID              string        `json:"id,omitempty"`
+	AccountID       int64         `json:"accountId,omitempty"`
+	Name            string        `json:"name"`
+	IP              string        `json:"ip"`
+	Note            string        `json:"note"`
+	Created         *Timestamp    `json:"created,omitempty"`
+	User            string        `json:"user,omitempty"`
+	Length          time.Duration `json:"length"`
+	Protocol        string        `json:"protocol"`
+	PortFrom        int           `json:"portFrom"`
+	PortTo          int           `json:"portTo"`
+	SRL             string        `json:"srl,omitempty"`
+	CloudAccountID  string        `json:"cloudAccountId"`
+	Region          string        `json:"region"`
+	SecurityGroupID int64         `json:"securityGroupId"`
+}
+
+// MarshalJSON encodes Length as the TimeSpan expected by the API.
+func (l AwsAccessLease) MarshalJSON() ([]byte, error) {
+	type alias AwsAccessLease
+	return json.Marshal(struct {
+		alias
+		Length timeSpan `json:"length"`
+	}{alias(l), timeSpan(l.Length)})
+}
+
+// UnmarshalJSON decodes Length from the TimeSpan returned by the API.
+func (l *AwsAccessLease) UnmarshalJSON(data []byte) error {
+	type alias AwsAccessLease
+	aux := struct {
+		*alias
+		Length timeSpan `json:"length"`
+	}{alias: (*alias)(l)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	l.Length = time.Duration(aux.Length)
+	return nil
+}
+
+// AzureAccessLease opens access from an IP to an Azure network security
+// group, on a port range, for Length. An empty IP is the IP of the user
+// creating the lease.
+type AzureAccessLease struct {
+	ID              string        `json:"id,omitempty"`
+	AccountID       int64         `json:"accountId,omitempty"`
+	Name            string        `json:"name"`
+	IP              string        `json:"ip"`
+	Note            string        `json:"note"`
+	Created         *Timestamp    `json:"created,omitempty"`
+	User            string        `json:"user,omitempty"`
+	Length          time.Duration `json:"length"`
+	Protocol        string        `json:"protocol"`
+	PortFrom        int           `json:"portFrom"`
+	PortTo          int           `json:"portTo"`
+	SRL             string        `json:"srl,omitempty"`
+	CloudAccountID  string        `json:"cloudAccountId"`
+	Region          string        `json:"region"`
+	SecurityGroupID string        `json:"securityGroupId"`
+}
+
+// MarshalJSON encodes Length as the TimeSpan expected by the API.
+func (l AzureAccessLease) MarshalJSON() ([]byte, error) {
+	type alias AzureAccessLease
+	return json.Marshal(struct {
+		alias
+		Length timeSpan `json:"length"`
+	}{alias(l), timeSpan(l.Length)})
+}
+
+// UnmarshalJSON decodes Length from the TimeSpan returned by the API.
+func (l *AzureAccessLease) UnmarshalJSON(data []byte) error {
+	type alias AzureAccessLease
+	aux := struct {
+		*alias
+		Length timeSpan `json:"length"`
+	}{alias: (*alias)(l)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	l.Length = time.Duration(aux.Length)
+	return nil
+}
+
+// AccessLeaseInvitation invites an external user, by email, to open an AWS
+// access lease from their IP. The invitation is valid for Length.
+type AccessLeaseInvitation struct {
+	ID         string          `json:"id,omitempty"`
+	Recipient  string          `json:"recipient"`
+	IssuerName string          `json:"issuerName"`
+	Created    *Timestamp      `json:"created,omitempty"`
+	Length     time.Duration   `json:"length"`
+	Lease      *AwsAccessLease `json:"lease"`
+}
+
+// MarshalJSON encodes Length as the TimeSpan expected by the API.
+func (i AccessLeaseInvitation) MarshalJSON() ([]byte, error) {
+	type alias AccessLeaseInvitation
+	return json.Marshal(struct {
+		alias
+		Length timeSpan `json:"length"`
+	}{alias(i), timeSpan(i.Length)})
+}
+
+// UnmarshalJSON decodes Length from the TimeSpan returned by the API.
+func (i *AccessLeaseInvitation) UnmarshalJSON(data []byte) error {
+	type alias AccessLeaseInvitation
+	aux := struct {
+		*alias
+		Length timeSpan `json:"length"`
+	}{alias: (*alias)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	i.Length = time.Duration(aux.Length)
+	return nil
+}
+
+// timeSpan is a duration encoded as a .NET TimeSpan, "[-][d.]hh:mm:ss[.fffffff]".
+// A null or empty TimeSpan decodes as zero.
+type timeSpan time.Duration
+
+func (ts timeSpan) MarshalJSON() ([]byte, error) {
+	d := time.Duration(ts)
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	h := d / time.Hour
+	d -= h * time.Hour
+	m := d / time.Minute
+	d -= m * time.Minute
+	s := d / time.Second
+	d -= s * time.Second
+
+	var b strings.Builder
+	b.WriteString(sign)
+	if days > 0 {
+		fmt.Fprintf(&b, "%d.", days)
+	}
+	fmt.Fprintf(&b, "%02d:%02d:%02d", h, m, s)
+	if d > 0 {
+		// TimeSpan ticks are 100ns.
+		fmt.Fprintf(&b, ".%07d", d/100)
+	}
+
+	return json.Marshal(b.String())
+}
+
+func (ts *timeSpan) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*ts = 0
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*ts = 0
+		return nil
+	}
+
+	d, err := parseTimeSpan(s)
+	if err != nil {
+		return err
+	}
+
+	*ts = timeSpan(d)
+	return nil
+}
+
+func parseTimeSpan(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("Invalid TimeSpan %q", s)
+
+	neg := strings.HasPrefix(s, "-")
+	v := strings.TrimPrefix(s, "-")
+
+	parts := strings.Split(v, ":")
+	if len(parts) != 3 {
+		return 0, invalid
+	}
+
+	var d time.Duration
+
+	// The hours can be preceded by the days.
+	hours := parts[0]
+	if i := strings.Index(hours, "."); i >= 0 {
+		days, err := strconv.ParseUint(hours[:i], 10, 32)
+		if err != nil {
+			return 0, invalid
+		}
+		d += time.Duration(days) * 24 * time.Hour
+		hours = hours[i+1:]
+	}
+	h, err := strconv.ParseUint(hours, 10, 8)
+	if err != nil || h > 23 {
+		return 0, invalid
+	}
+	m, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || m > 59 {
+		return 0, invalid
+	}
+
+	// The seconds can be followed by a fraction, of up to 7 digits.
+	seconds := parts[2]
+	if i := strings.Index(seconds, "."); i >= 0 {
+		fraction := seconds[i+1:]
+		if len(fraction) == 0 || len(fraction) > 7 {
+			return 0, invalid
+		}
+		f, err := strconv.ParseUint(fraction+strings.Repeat("0", 7-len(fraction)), 10, 32)
+		if err != nil {
+			return 0, invalid
+		}
+		d += time.Duration(f) * 100
+		seconds = seconds[:i]
+	}
+	sec, err := strconv.ParseUint(seconds, 10, 8)
+	if err != nil || sec > 59 {
+		return 0, invalid
+	}
+
+	d += time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second
+	if neg {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// List the active access leases.
+func (s *AccessLeasesServiceOp) List(ctx context.Context) (*AccessLeases, *http.Response, error) {
+	path := accessLeasesBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leases := new(AccessLeases)
+	resp, err := s.client.Do(ctx, req, leases)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return leases, resp, err
+}
+
+// CreateAws creates an AWS access lease.
+func (s *AccessLeasesServiceOp) CreateAws(ctx context.Context, lease *AwsAccessLease) (*AwsAccessLease, *http.Response, error) {
+	path := fmt.Sprintf("%s/Aws", accessLeasesBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, lease)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AwsAccessLease)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// CreateAzure creates an Azure access lease.
+func (s *AccessLeasesServiceOp) CreateAzure(ctx context.Context, lease *AzureAccessLease) (*AzureAccessLease, *http.Response, error) {
+	path := fmt.Sprintf("%s/Azure", accessLeasesBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, lease)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AzureAccessLease)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// CreateInvitation invites an external user to open an access lease.
+func (s *AccessLeasesServiceOp) CreateInvitation(ctx context.Context, invitation *AccessLeaseInvitation) (*AccessLeaseInvitation, *http.Response, error) {
+	path := accessLeaseInvitationBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AccessLeaseInvitation)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Terminate an access lease before it expires, closing the access it opened.
+func (s *AccessLeasesServiceOp) Terminate(ctx context.Context, leaseID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", accessLeasesBasePath, leaseID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Terminate returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
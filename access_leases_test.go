@@ -0,0 +1,261 @@
+package dome9
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testAccessLeaseID = "00000000-0000-0000-0000-000000000000"
+
+func TestAccessLeases_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AccessLease", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "aws": [
+    {
+      "id": "00000000-0000-0000-0000-000000000000",
+      "accountId": 1337,
+      "name": "ssh",
+      "ip": "10.0.0.1",
+      "note": "on-call",
+      "created": "2018-08-26T16:11:12Z",
+      "user": "user@example.com",
+      "length": "02:30:00",
+      "protocol": "TCP",
+      "portFrom": 22,
+      "portTo": 22,
+      "srl": "1|123456789012|us_east_1|sg-1337",
+      "cloudAccountId": "1337-acct",
+      "region": "us_east_1",
+      "securityGroupId": 1337
+    }
+  ],
+  "azure": [
+    {
+      "id": "1",
+      "name": "rdp",
+      "length": "1.00:00:00",
+      "protocol": "TCP",
+      "portFrom": 3389,
+      "portTo": 3389,
+      "cloudAccountId": "1337-acct",
+      "region": "westeurope",
+      "securityGroupId": "00000000-0000-0000-0000-000000000001"
+    }
+  ]
+}`)
+	})
+
+	leases, _, err := client.AccessLeases.List(ctx)
+	if err != nil {
+		t.Errorf("AccessLeases.List returned error: %v", err)
+	}
+
+	expected := &AccessLeases{
+		Aws: []AwsAccessLease{{
+			ID:              testAccessLeaseID,
+			AccountID:       1337,
+			Name:            "ssh",
+			IP:              "10.0.0.1",
+			Note:            "on-call",
+			Created:         &testTimestamp,
+			User:            "user@example.com",
+			Length:          2*time.Hour + 30*time.Minute,
+			Protocol:        "TCP",
+			PortFrom:        22,
+			PortTo:          22,
+			SRL:             "1|123456789012|us_east_1|sg-1337",
+			CloudAccountID:  testAccountID,
+			Region:          "us_east_1",
+			SecurityGroupID: 1337,
+		}},
+		Azure: []AzureAccessLease{{
+			ID:              "1",
+			Name:            "rdp",
+			Length:          24 * time.Hour,
+			Protocol:        "TCP",
+			PortFrom:        3389,
+			PortTo:          3389,
+			CloudAccountID:  testAccountID,
+			Region:          "westeurope",
+			SecurityGroupID: "00000000-0000-0000-0000-000000000001",
+		}},
+	}
+
+	if !reflect.DeepEqual(leases, expected) {
+		t.Errorf("AccessLeases.List\n got=%#v\nwant=%#v", leases, expected)
+	}
+}
+
+func TestAccessLeases_CreateAws(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AccessLease/Aws", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"ssh","ip":"10.0.0.1","note":"on-call","protocol":"TCP","portFrom":22,"portTo":22,"cloudAccountId":"`+testAccountID+`","region":"us_east_1","securityGroupId":1337,"length":"02:30:00"}`)
+		fmt.Fprint(w, `{"id": "`+testAccessLeaseID+`", "name": "ssh", "length": "02:30:00"}`)
+	})
+
+	lease := &AwsAccessLease{
+		Name:            "ssh",
+		IP:              "10.0.0.1",
+		Note:            "on-call",
+		Length:          150 * time.Minute,
+		Protocol:        "TCP",
+		PortFrom:        22,
+		PortTo:          22,
+		CloudAccountID:  testAccountID,
+		Region:          "us_east_1",
+		SecurityGroupID: 1337,
+	}
+
+	created, _, err := client.AccessLeases.CreateAws(ctx, lease)
+	if err != nil {
+		t.Errorf("AccessLeases.CreateAws returned error: %v", err)
+	}
+
+	if expected := (&AwsAccessLease{ID: testAccessLeaseID, Name: "ssh", Length: 150 * time.Minute}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("AccessLeases.CreateAws\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestAccessLeases_CreateAzure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AccessLease/Azure", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"rdp","ip":"","note":"","protocol":"TCP","portFrom":3389,"portTo":3389,"cloudAccountId":"`+testAccountID+`","region":"westeurope","securityGroupId":"nsg","length":"00:45:00"}`)
+		fmt.Fprint(w, `{"id": "1", "length": "00:45:00"}`)
+	})
+
+	lease := &AzureAccessLease{
+		Name:            "rdp",
+		Length:          45 * time.Minute,
+		Protocol:        "TCP",
+		PortFrom:        3389,
+		PortTo:          3389,
+		CloudAccountID:  testAccountID,
+		Region:          "westeurope",
+		SecurityGroupID: "nsg",
+	}
+
+	created, _, err := client.AccessLeases.CreateAzure(ctx, lease)
+	if err != nil {
+		t.Errorf("AccessLeases.CreateAzure returned error: %v", err)
+	}
+
+	if expected := (&AzureAccessLease{ID: "1", Length: 45 * time.Minute}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("AccessLeases.CreateAzure\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestAccessLeases_CreateInvitation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AccessLeaseInvitation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"recipient":"contractor@example.com","issuerName":"on-call","lease":{"name":"ssh","ip":"","note":"","protocol":"TCP","portFrom":22,"portTo":22,"cloudAccountId":"`+testAccountID+`","region":"us_east_1","securityGroupId":1337,"length":"01:00:00"},"length":"7.00:00:00"}`)
+		fmt.Fprint(w, `{"id": "1", "recipient": "contractor@example.com", "length": "7.00:00:00"}`)
+	})
+
+	invitation := &AccessLeaseInvitation{
+		Recipient:  "contractor@example.com",
+		IssuerName: "on-call",
+		Length:     7 * 24 * time.Hour,
+		Lease: &AwsAccessLease{
+			Name:            "ssh",
+			Length:          time.Hour,
+			Protocol:        "TCP",
+			PortFrom:        22,
+			PortTo:          22,
+			CloudAccountID:  testAccountID,
+			Region:          "us_east_1",
+			SecurityGroupID: 1337,
+		},
+	}
+
+	created, _, err := client.AccessLeases.CreateInvitation(ctx, invitation)
+	if err != nil {
+		t.Errorf("AccessLeases.CreateInvitation returned error: %v", err)
+	}
+
+	if expected := (&AccessLeaseInvitation{ID: "1", Recipient: "contractor@example.com", Length: 7 * 24 * time.Hour}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("AccessLeases.CreateInvitation\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestAccessLeases_Terminate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AccessLease/"+testAccessLeaseID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AccessLeases.Terminate(ctx, testAccessLeaseID)
+	if err != nil {
+		t.Errorf("AccessLeases.Terminate returned error: %v", err)
+	}
+}
+
+func TestTimeSpan(t *testing.T) {
+	tests := []struct {
+		d time.Duration
+		s string
+	}{
+		{0, "00:00:00"},
+		{90 * time.Second, "00:01:30"},
+		{26*time.Hour + 3*time.Minute, "1.02:03:00"},
+		{-2 * time.Hour, "-02:00:00"},
+		{time.Second + 1500*time.Millisecond, "00:00:02.5000000"},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(timeSpan(tt.d))
+		if err != nil {
+			t.Errorf("timeSpan(%v).MarshalJSON returned error: %v", tt.d, err)
+		}
+		if expected := `"` + tt.s + `"`; string(data) != expected {
+			t.Errorf("timeSpan(%v).MarshalJSON = %s, expected %s", tt.d, data, expected)
+		}
+
+		var ts timeSpan
+		if err := json.Unmarshal(data, &ts); err != nil {
+			t.Errorf("timeSpan.UnmarshalJSON(%s) returned error: %v", data, err)
+		}
+		if time.Duration(ts) != tt.d {
+			t.Errorf("timeSpan.UnmarshalJSON(%s) = %v, expected %v", data, time.Duration(ts), tt.d)
+		}
+	}
+
+	for _, data := range []string{`{"length": null}`, `{"length": ""}`} {
+		lease := AwsAccessLease{Length: time.Hour}
+		if err := json.Unmarshal([]byte(data), &lease); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", data, err)
+		}
+		if lease.Length != 0 {
+			t.Errorf("json.Unmarshal(%s) Length = %v, expected 0", data, lease.Length)
+		}
+	}
+
+	if d, err := parseTimeSpan("00:00:01.5"); err != nil || d != 1500*time.Millisecond {
+		t.Errorf("parseTimeSpan(00:00:01.5) = %v, %v, expected %v", d, err, 1500*time.Millisecond)
+	}
+
+	for _, s := range []string{"", "1:2", "24:00:00", "00:60:00", "00:00:60", "x.00:00:00", "00:00:00.", "00:00:00.12345678"} {
+		if _, err := parseTimeSpan(s); err == nil {
+			t.Errorf("parseTimeSpan(%q) expected error", s)
+		}
+	}
+}
@@ -151,7 +151,7 @@ func (s *AccountTrustsServiceOp) Delete(ctx context.Context, trustID string) (*h
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
 	resp, err := s.client.Do(ctx, req, nil)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 const assessmentHistoriesBasePath = "v2/AssessmentHistoryV2"
@@ -15,7 +17,7 @@ const assessmentHistoriesBasePath = "v2/AssessmentHistoryV2"
 // retrieve the specific assessment results.
 // See: https://api-v2-docs.dome9.com/#Dome9-API-AssessmentHistoryV2
 type AssessmentHistoriesService interface {
-	GetBundleResults(context.Context, string, string, string, string, string) ([]AssessmentHistoryResult, *http.Response, error)
+	GetBundleResults(context.Context, string, string, time.Time, string, string) ([]AssessmentHistoryResult, *http.Response, error)
 	GetAssessmentResult(context.Context, string) (*AssessmentHistoryResult, *http.Response, error)
 	DeleteAssessmentResult(context.Context, string) (*http.Response, error)
 }
@@ -32,8 +34,8 @@ var _ AssessmentHistoriesService = &AssessmentHistoriesServiceOp{}
 type AssessmentHistoryResult struct {
 	TriggeredBy      string                        `json:"triggeredBy"`
 	Tests            []RuleTestResult              `json:"tests"`
-	TestEntities     interface{}                   `json:"testEntities"`
-	CreatedTime      string                        `json:"createdTime"`
+	TestEntities     TestEntities                  `json:"testEntities"`
+	CreatedTime      Timestamp                     `json:"createdTime"`
 	ID               int64                         `json:"id"`
 	AssessmentPassed bool                          `json:"assessmentPassed"`
 	HasErrors        bool                          `json:"hasErrors"`
@@ -68,9 +70,13 @@ type AssessmentHistoryBundleResult struct {
 	RequestID              string               `json:"requestId"`
 }
 
-// GetBundleResults
-func (s *AssessmentHistoriesServiceOp) GetBundleResults(ctx context.Context, bundleID, cloudAccountIDs, fromTime, epsilonInMinutes, requestID string) ([]AssessmentHistoryResult, *http.Response, error) {
-	path := fmt.Sprintf("%s?bundleId=%s&cloudAccountIds=%s&fromTime=%s&epsilonInMinutes=%s&requestId=%s", assessmentHistoriesBasePath, bundleID, cloudAccountIDs, fromTime, epsilonInMinutes, requestID)
+// GetBundleResults returns the results of the assessments of a bundle run
+// after fromTime. The fromTime parameter is omitted when fromTime is zero.
+func (s *AssessmentHistoriesServiceOp) GetBundleResults(ctx context.Context, bundleID, cloudAccountIDs string, fromTime time.Time, epsilonInMinutes, requestID string) ([]AssessmentHistoryResult, *http.Response, error) {
+	path := fmt.Sprintf("%s?bundleId=%s&cloudAccountIds=%s&epsilonInMinutes=%s&requestId=%s", assessmentHistoriesBasePath, bundleID, cloudAccountIDs, epsilonInMinutes, requestID)
+	if !fromTime.IsZero() {
+		path += "&fromTime=" + url.QueryEscape(fromTime.UTC().Format(time.RFC3339))
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
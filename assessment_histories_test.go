@@ -1,10 +1,12 @@
 package dome9
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestAssessmentHistories_GetBundleResults(t *testing.T) {
@@ -13,6 +15,9 @@ func TestAssessmentHistories_GetBundleResults(t *testing.T) {
 
 	mux.HandleFunc("/v2/AssessmentHistoryV2", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		if got, expected := r.URL.Query().Get("fromTime"), "2018-08-26T16:11:12Z"; got != expected {
+			t.Errorf("AssessmentHistories.GetBundleResults fromTime = %v, expected %v", got, expected)
+		}
 		fmt.Fprint(w, `[{
   "triggeredBy": "Unknown",
   "tests": [
@@ -98,7 +103,7 @@ func TestAssessmentHistories_GetBundleResults(t *testing.T) {
 }]`)
 	})
 
-	assessmentHistories, _, err := client.AssessmentHistories.GetBundleResults(ctx, "123", "abc,def", "2018-08-26T16:11:12Z", "1", "0000")
+	assessmentHistories, _, err := client.AssessmentHistories.GetBundleResults(ctx, "123", "abc,def", testTimestamp.Time, "1", "0000")
 	if err != nil {
 		t.Errorf("AssessmentHistories.GetBundleResults returned error: %v", err)
 	}
@@ -114,7 +119,7 @@ func TestAssessmentHistories_GetBundleResults(t *testing.T) {
 				Relevant: true,
 				Valid:    true,
 				Error:    "string",
-				TestObj:  map[string]interface{}{}}},
+				TestObj:  &TestObject{}}},
 			Rule: &RuleEntity{
 				Name:          "string",
 				Severity:      "Low",
@@ -129,10 +134,10 @@ func TestAssessmentHistories_GetBundleResults(t *testing.T) {
 				LogicHash:     "string",
 				Default:       true},
 			TestPassed: true}},
-		TestEntities: map[string]interface{}{
-			"notSupported": []interface{}{map[string]interface{}{}},
-			"instance":     []interface{}{map[string]interface{}{}}},
-		CreatedTime:      "2018-08-26T16:11:12Z",
+		TestEntities: TestEntities{
+			"notSupported": {json.RawMessage("{}")},
+			"instance":     {json.RawMessage("{}")}},
+		CreatedTime:      testTimestamp,
 		ID:               0,
 		AssessmentPassed: true,
 		HasErrors:        true,
@@ -171,6 +176,24 @@ func TestAssessmentHistories_GetBundleResults(t *testing.T) {
 	}
 }
 
+func TestAssessmentHistories_GetBundleResults_zeroFromTime(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AssessmentHistoryV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if _, ok := r.URL.Query()["fromTime"]; ok {
+			t.Errorf("AssessmentHistories.GetBundleResults sent fromTime for a zero time")
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.AssessmentHistories.GetBundleResults(ctx, "123", "abc,def", time.Time{}, "1", "0000")
+	if err != nil {
+		t.Errorf("AssessmentHistories.GetBundleResults returned error: %v", err)
+	}
+}
+
 func TestAssessmentHistories_GetAssessmentResult(t *testing.T) {
 	setup()
 	defer teardown()
@@ -277,7 +300,7 @@ func TestAssessmentHistories_GetAssessmentResult(t *testing.T) {
 				Relevant: true,
 				Valid:    true,
 				Error:    "string",
-				TestObj:  map[string]interface{}{}}},
+				TestObj:  &TestObject{}}},
 			Rule: &RuleEntity{
 				Name:          "string",
 				Severity:      "Low",
@@ -292,10 +315,10 @@ func TestAssessmentHistories_GetAssessmentResult(t *testing.T) {
 				LogicHash:     "string",
 				Default:       true},
 			TestPassed: true}},
-		TestEntities: map[string]interface{}{
-			"notSupported": []interface{}{map[string]interface{}{}},
-			"instance":     []interface{}{map[string]interface{}{}}},
-		CreatedTime:      "2018-08-26T16:11:12Z",
+		TestEntities: TestEntities{
+			"notSupported": {json.RawMessage("{}")},
+			"instance":     {json.RawMessage("{}")}},
+		CreatedTime:      testTimestamp,
 		ID:               0,
 		AssessmentPassed: true,
 		HasErrors:        true,
@@ -2,8 +2,10 @@ package dome9
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 const assessmentsBasePath = "v2/assessment"
@@ -63,7 +65,7 @@ type AssessmentResult struct {
 	Request          BaseAssessmentRequest `json:"request"`
 	Tests            []RuleTestResult      `json:"tests"`
 	LocationMetadata *LocationMetadata     `json:"locationMetadata"`
-	TestEntities     interface{}           `json:"testEntities"`
+	TestEntities     TestEntities          `json:"testEntities"`
 	AssessmentPassed bool                  `json:"assessmentPassed"`
 	HasErrors        bool                  `json:"hasErrors"`
 	ID               int64                 `json:"id"`
@@ -96,7 +98,47 @@ type ValidationResult struct {
 	Relevant bool        `json:"isRelevant"`
 	Valid    bool        `json:"isValid"`
 	Error    string      `json:"error"`
-	TestObj  interface{} `json:"testObj"`
+	TestObj  *TestObject `json:"testObj"`
+}
+
+// TestObject identifies the entity a ValidationResult is about, by its type
+// and index in the TestEntities of the assessment.
+type TestObject struct {
+	ID                         string `json:"id"`
+	Dome9ID                    string `json:"dome9Id"`
+	EntityType                 string `json:"entityType"`
+	EntityIndex                int    `json:"entityIndex"`
+	CustomEntityComparisonHash string `json:"customEntityComparisonHash"`
+}
+
+// TestEntities are the entities tested by an assessment, by entity type. The
+// entities are left as raw JSON, as their fields depend on their type.
+type TestEntities map[string][]json.RawMessage
+
+// Entity returns the JSON of the entity a validation result is about. It
+// returns false if the result has no test object or the entity isn't found.
+func (e TestEntities) Entity(result *ValidationResult) (json.RawMessage, bool) {
+	if result.TestObj == nil {
+		return nil, false
+	}
+
+	entities, ok := e[result.TestObj.EntityType]
+	if !ok {
+		// Entity types aren't always cased the same in test objects.
+		for entityType, typeEntities := range e {
+			if strings.EqualFold(entityType, result.TestObj.EntityType) {
+				entities, ok = typeEntities, true
+				break
+			}
+		}
+	}
+
+	i := result.TestObj.EntityIndex
+	if !ok || i < 0 || i >= len(entities) {
+		return nil, false
+	}
+
+	return entities[i], true
 }
 
 // RuleEntity
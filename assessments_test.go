@@ -1,6 +1,7 @@
 package dome9
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -305,7 +306,7 @@ func TestAssessments_RunBundle(t *testing.T) {
 				Relevant: true,
 				Valid:    true,
 				Error:    "string",
-				TestObj:  map[string]interface{}{}}},
+				TestObj:  &TestObject{}}},
 			Rule: &RuleEntity{
 				Name:          "string",
 				Severity:      "Low",
@@ -336,68 +337,68 @@ func TestAssessments_RunBundle(t *testing.T) {
 				Name:       "string",
 				ID:         "string",
 				ExternalID: "string"}},
-		TestEntities: map[string]interface{}{
-			"sqldb":                   []interface{}{map[string]interface{}{}},
-			"applicationGateway":      []interface{}{map[string]interface{}{}},
-			"iamPolicy":               []interface{}{map[string]interface{}{}},
-			"volume":                  []interface{}{map[string]interface{}{}},
-			"nacl":                    []interface{}{map[string]interface{}{}},
-			"subnet":                  []interface{}{map[string]interface{}{}},
-			"loadBalancer":            []interface{}{map[string]interface{}{}},
-			"kms":                     []interface{}{map[string]interface{}{}},
-			"default":                 []interface{}{map[string]interface{}{}},
-			"wafRegional":             []interface{}{map[string]interface{}{}},
-			"storageAccount":          []interface{}{map[string]interface{}{}},
-			"notSupported":            []interface{}{map[string]interface{}{}},
-			"securityGroup":           []interface{}{map[string]interface{}{}},
-			"vmInstance":              []interface{}{map[string]interface{}{}},
-			"iamGroup":                []interface{}{map[string]interface{}{}},
-			"rds":                     []interface{}{map[string]interface{}{}},
-			"virtualMachine":          []interface{}{map[string]interface{}{}},
-			"applicationLoadBalancer": []interface{}{map[string]interface{}{}},
-			"vpnGateway":              []interface{}{map[string]interface{}{}},
-			"vpnConnection":           []interface{}{map[string]interface{}{}},
-			"region":                  []interface{}{map[string]interface{}{}},
-			"sqlServer":               []interface{}{map[string]interface{}{}},
-			"kinesis":                 []interface{}{map[string]interface{}{}},
-			"elastiCache":             []interface{}{map[string]interface{}{}},
-			"dynamoDbTable":           []interface{}{map[string]interface{}{}},
-			"gcpSecurityGroup":        []interface{}{map[string]interface{}{}},
-			"networkSecurityGroup":    []interface{}{map[string]interface{}{}},
-			"vpc":                     []interface{}{map[string]interface{}{}},
-			"iamUser":                 []interface{}{map[string]interface{}{}},
-			"network":                 []interface{}{map[string]interface{}{}},
-			"cloudFront":              []interface{}{map[string]interface{}{}},
-			"ecsTask":                 []interface{}{map[string]interface{}{}},
-			"elb":                     []interface{}{map[string]interface{}{}},
-			"resourceGroup":           []interface{}{map[string]interface{}{}},
-			"route53HostedZone":       []interface{}{map[string]interface{}{}},
-			"redisCache":              []interface{}{map[string]interface{}{}},
-			"networkLoadBalancer":     []interface{}{map[string]interface{}{}},
-			"customerGateway":         []interface{}{map[string]interface{}{}},
-			"iamRole":                 []interface{}{map[string]interface{}{}},
-			"redshift":                []interface{}{map[string]interface{}{}},
-			"networkInterface":        []interface{}{map[string]interface{}{}},
-			"acmCertificate":          []interface{}{map[string]interface{}{}},
-			"lock":                    []interface{}{map[string]interface{}{}},
-			"iamInstanceProfile":      []interface{}{map[string]interface{}{}},
-			"s3Bucket":                []interface{}{map[string]interface{}{}},
-			"iam":                     []interface{}{map[string]interface{}{}},
-			"vNet":                    []interface{}{map[string]interface{}{}},
-			"internetGateway":         []interface{}{map[string]interface{}{}},
-			"route53RecordSetGroup":   []interface{}{map[string]interface{}{}},
-			"virtualMfaDevices":       []interface{}{map[string]interface{}{}},
-			"ecsTaskDefinition":       []interface{}{map[string]interface{}{}},
-			"route53Domain":           []interface{}{map[string]interface{}{}},
-			"ami":                     []interface{}{map[string]interface{}{}},
-			"iamServerCertificate":    []interface{}{map[string]interface{}{}},
-			"elasticIP":               []interface{}{map[string]interface{}{}},
-			"instance":                []interface{}{map[string]interface{}{}},
-			"cloudTrail":              []interface{}{map[string]interface{}{}},
-			"keyVault":                []interface{}{map[string]interface{}{}},
-			"lambda":                  []interface{}{map[string]interface{}{}},
-			"efs":                     []interface{}{map[string]interface{}{}},
-			"ecsCluster":              []interface{}{map[string]interface{}{}}},
+		TestEntities: TestEntities{
+			"sqldb":                   {json.RawMessage("{}")},
+			"applicationGateway":      {json.RawMessage("{}")},
+			"iamPolicy":               {json.RawMessage("{}")},
+			"volume":                  {json.RawMessage("{}")},
+			"nacl":                    {json.RawMessage("{}")},
+			"subnet":                  {json.RawMessage("{}")},
+			"loadBalancer":            {json.RawMessage("{}")},
+			"kms":                     {json.RawMessage("{}")},
+			"default":                 {json.RawMessage("{}")},
+			"wafRegional":             {json.RawMessage("{}")},
+			"storageAccount":          {json.RawMessage("{}")},
+			"notSupported":            {json.RawMessage("{}")},
+			"securityGroup":           {json.RawMessage("{}")},
+			"vmInstance":              {json.RawMessage("{}")},
+			"iamGroup":                {json.RawMessage("{}")},
+			"rds":                     {json.RawMessage("{}")},
+			"virtualMachine":          {json.RawMessage("{}")},
+			"applicationLoadBalancer": {json.RawMessage("{}")},
+			"vpnGateway":              {json.RawMessage("{}")},
+			"vpnConnection":           {json.RawMessage("{}")},
+			"region":                  {json.RawMessage("{}")},
+			"sqlServer":               {json.RawMessage("{}")},
+			"kinesis":                 {json.RawMessage("{}")},
+			"elastiCache":             {json.RawMessage("{}")},
+			"dynamoDbTable":           {json.RawMessage("{}")},
+			"gcpSecurityGroup":        {json.RawMessage("{}")},
+			"networkSecurityGroup":    {json.RawMessage("{}")},
+			"vpc":                     {json.RawMessage("{}")},
+			"iamUser":                 {json.RawMessage("{}")},
+			"network":                 {json.RawMessage("{}")},
+			"cloudFront":              {json.RawMessage("{}")},
+			"ecsTask":                 {json.RawMessage("{}")},
+			"elb":                     {json.RawMessage("{}")},
+			"resourceGroup":           {json.RawMessage("{}")},
+			"route53HostedZone":       {json.RawMessage("{}")},
+			"redisCache":              {json.RawMessage("{}")},
+			"networkLoadBalancer":     {json.RawMessage("{}")},
+			"customerGateway":         {json.RawMessage("{}")},
+			"iamRole":                 {json.RawMessage("{}")},
+			"redshift":                {json.RawMessage("{}")},
+			"networkInterface":        {json.RawMessage("{}")},
+			"acmCertificate":          {json.RawMessage("{}")},
+			"lock":                    {json.RawMessage("{}")},
+			"iamInstanceProfile":      {json.RawMessage("{}")},
+			"s3Bucket":                {json.RawMessage("{}")},
+			"iam":                     {json.RawMessage("{}")},
+			"vNet":                    {json.RawMessage("{}")},
+			"internetGateway":         {json.RawMessage("{}")},
+			"route53RecordSetGroup":   {json.RawMessage("{}")},
+			"virtualMfaDevices":       {json.RawMessage("{}")},
+			"ecsTaskDefinition":       {json.RawMessage("{}")},
+			"route53Domain":           {json.RawMessage("{}")},
+			"ami":                     {json.RawMessage("{}")},
+			"iamServerCertificate":    {json.RawMessage("{}")},
+			"elasticIP":               {json.RawMessage("{}")},
+			"instance":                {json.RawMessage("{}")},
+			"cloudTrail":              {json.RawMessage("{}")},
+			"keyVault":                {json.RawMessage("{}")},
+			"lambda":                  {json.RawMessage("{}")},
+			"efs":                     {json.RawMessage("{}")},
+			"ecsCluster":              {json.RawMessage("{}")}},
 		AssessmentPassed: true,
 		HasErrors:        true,
 		ID:               0}
@@ -406,3 +407,53 @@ func TestAssessments_RunBundle(t *testing.T) {
 		t.Errorf("Assessments.RunBundle\n got=%#v\nwant=%#v", assessmentResult, expected)
 	}
 }
+
+func TestTestEntities_Entity(t *testing.T) {
+	var result AssessmentResult
+	err := json.Unmarshal([]byte(`{
+  "tests": [
+    {
+      "entityResults": [
+        {
+          "isRelevant": true,
+          "isValid": false,
+          "testObj": {
+            "id": "sg-2",
+            "dome9Id": "1337",
+            "entityType": "SecurityGroup",
+            "entityIndex": 1,
+            "customEntityComparisonHash": "hash"
+          }
+        },
+        {"isRelevant": true, "isValid": false, "testObj": {"entityType": "securityGroup", "entityIndex": 2}},
+        {"isRelevant": true, "isValid": false, "testObj": null}
+      ]
+    }
+  ],
+  "testEntities": {
+    "securityGroup": [{"id": "sg-1"}, {"id": "sg-2"}]
+  }
+}`), &result)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	results := result.Tests[0].EntityResults
+
+	expectedTestObj := &TestObject{ID: "sg-2", Dome9ID: "1337", EntityType: "SecurityGroup", EntityIndex: 1, CustomEntityComparisonHash: "hash"}
+	if !reflect.DeepEqual(results[0].TestObj, expectedTestObj) {
+		t.Errorf("ValidationResult.TestObj\n got=%#v\nwant=%#v", results[0].TestObj, expectedTestObj)
+	}
+
+	entity, ok := result.TestEntities.Entity(&results[0])
+	if expected := `{"id": "sg-2"}`; !ok || string(entity) != expected {
+		t.Errorf("TestEntities.Entity = %s, %v, expected %s", entity, ok, expected)
+	}
+
+	if entity, ok := result.TestEntities.Entity(&results[1]); ok {
+		t.Errorf("TestEntities.Entity = %s for an index out of range", entity)
+	}
+	if entity, ok := result.TestEntities.Entity(&results[2]); ok {
+		t.Errorf("TestEntities.Entity = %s for a result without test object", entity)
+	}
+}
@@ -0,0 +1,188 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const assetsBasePath = "v2/protected-asset"
+
+// AssetsService resource has methods to search the protected assets, the
+// normalized inventory of cloud entities evaluated by compliance assessments.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-ProtectedAsset
+type AssetsService interface {
+	Search(context.Context, *AssetSearchRequest) (*AssetSearchResponse, *http.Response, error)
+	Iterate(context.Context, *AssetSearchRequest) *AssetIterator
+}
+
+// AssetsServiceOp handles communication with the Protected Assets
+// related methods of the Dome9 API.
+type AssetsServiceOp struct {
+	client *Client
+}
+
+var _ AssetsService = &AssetsServiceOp{}
+
+// AssetSearchRequest is a protected assets search. Aggregations lists the
+// fields to count the matching assets by, e.g. "type" or "cloudAccountId".
+// Pages after the first one are requested by setting SearchAfter to the value
+// returned with the previous page.
+type AssetSearchRequest struct {
+	PageSize     int                `json:"pageSize,omitempty"`
+	Sorting      *SearchSorting     `json:"sorting,omitempty"`
+	Filter       *AssetSearchFilter `json:"filter,omitempty"`
+	Aggregations []string           `json:"aggregations,omitempty"`
+	SearchAfter  []string           `json:"searchAfter,omitempty"`
+}
+
+// AssetSearchFilter filters the results of a protected assets search. Each
+// filter set narrows the results to the assets with one of its values.
+type AssetSearchFilter struct {
+	CloudAccountIDs []string
+	Types           []string
+	Regions         []string
+	Tags            []EntityTag
+	FreeText        string
+
+	// Additional filters on fields not covered above.
+	Fields []SearchFilterField
+}
+
+// MarshalJSON encodes the filter as the field list expected by the API.
+func (f AssetSearchFilter) MarshalJSON() ([]byte, error) {
+	filter := struct {
+		searchFilter
+		Tags []EntityTag `json:"tags,omitempty"`
+	}{
+		searchFilter: searchFilter{FreeTextPhrase: f.FreeText},
+		Tags:         f.Tags,
+	}
+
+	filter.addField("cloudAccountId", f.CloudAccountIDs...)
+	filter.addField("type", f.Types...)
+	filter.addField("region", f.Regions...)
+	filter.Fields = append(filter.Fields, f.Fields...)
+
+	return json.Marshal(filter)
+}
+
+// AssetSearchResponse is a page of protected assets search results.
+// Aggregations maps each field requested in the search to the count of
+// matching assets by value of the field.
+type AssetSearchResponse struct {
+	Assets       []Asset                       `json:"assets"`
+	TotalCount   int64                         `json:"totalCount"`
+	SearchAfter  []string                      `json:"searchAfter"`
+	Aggregations map[string][]AggregationValue `json:"aggregations"`
+}
+
+// AggregationValue is the count of search results with a value of a field.
+type AggregationValue struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Asset is a protected asset, a cloud entity known to Dome9. EntityID is the
+// Dome9 ID of the entity, as in the results of compliance assessments.
+type Asset struct {
+	ID             string      `json:"id"`
+	EntityID       string      `json:"entityId"`
+	ExternalID     string      `json:"externalId"`
+	SRL            string      `json:"srl"`
+	Name           string      `json:"name"`
+	Type           string      `json:"type"`
+	Platform       string      `json:"platform"`
+	CloudAccountID string      `json:"cloudAccountId"`
+	Region         string      `json:"region"`
+	Network        string      `json:"network"`
+	Tags           []EntityTag `json:"tags"`
+}
+
+// LocationConventionMetadata returns the SRL, name and IDs of the asset in
+// the form used by the results of compliance assessments.
+func (a *Asset) LocationConventionMetadata() *LocationConventionMetadata {
+	return &LocationConventionMetadata{SRL: a.SRL, Name: a.Name, ID: a.EntityID, ExternalID: a.ExternalID}
+}
+
+// Search protected assets. Only a page of results is returned, use Iterate
+// to walk all of them.
+func (s *AssetsServiceOp) Search(ctx context.Context, searchRequest *AssetSearchRequest) (*AssetSearchResponse, *http.Response, error) {
+	path := fmt.Sprintf("%s/search", assetsBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, searchRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(AssetSearchResponse)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// Iterate returns an iterator over the assets matching searchRequest. A nil
+// searchRequest iterates over all protected assets.
+func (s *AssetsServiceOp) Iterate(ctx context.Context, searchRequest *AssetSearchRequest) *AssetIterator {
+	if searchRequest == nil {
+		searchRequest = &AssetSearchRequest{}
+	}
+
+	request := *searchRequest
+	it := new(AssetIterator)
+	it.fetch = func() ([]interface{}, bool, error) {
+		result, _, err := s.Search(ctx, &request)
+		if err != nil {
+			return nil, false, err
+		}
+
+		request.SearchAfter = result.SearchAfter
+		page := make([]interface{}, len(result.Assets))
+		for i, asset := range result.Assets {
+			page[i] = asset
+		}
+
+		last := len(result.SearchAfter) == 0 || (request.PageSize > 0 && len(page) < request.PageSize)
+		return page, last, nil
+	}
+
+	return it
+}
+
+// AssetIterator iterates over the results of a protected assets search,
+// fetching the pages of results as needed. Aggregations are only returned by
+// Search.
+//
+//	it := client.Assets.Iterate(ctx, searchRequest)
+//	for it.Next() {
+//		asset := it.Asset()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type AssetIterator struct {
+	pager
+}
+
+// Next advances the iterator to the next asset, which is then available
+// through Asset. It returns false when there are no more assets or an error
+// occurred.
+func (it *AssetIterator) Next() bool {
+	return it.next()
+}
+
+// Asset returns the current asset.
+func (it *AssetIterator) Asset() Asset {
+	asset, _ := it.current.(Asset)
+	return asset
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AssetIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,151 @@
+package dome9
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAssets_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/protected-asset/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"pageSize":50,"filter":{"freeTextPhrase":"web","fields":[{"name":"cloudAccountId","value":"`+testAccountID+`"},{"name":"type","value":"Instance"},{"name":"region","value":"us_east_1"}],"tags":[{"key":"env","value":"prod"}]},"aggregations":["type"]}`)
+		fmt.Fprint(w, `{
+  "assets": [
+    {
+      "id": "1",
+      "entityId": "i-0123456789abcdef0",
+      "externalId": "i-0123456789abcdef0",
+      "srl": "1|123456789012|us_east_1|vpc-12345678|Instance|i-0123456789abcdef0",
+      "name": "web-1",
+      "type": "Instance",
+      "platform": "aws",
+      "cloudAccountId": "1337-acct",
+      "region": "us_east_1",
+      "network": "vpc-12345678",
+      "tags": [{"key": "env", "value": "prod"}]
+    }
+  ],
+  "totalCount": 1,
+  "searchAfter": ["1"],
+  "aggregations": {"type": [{"value": "Instance", "count": 1}]}
+}`)
+	})
+
+	searchRequest := &AssetSearchRequest{
+		PageSize: 50,
+		Filter: &AssetSearchFilter{
+			CloudAccountIDs: []string{testAccountID},
+			Types:           []string{"Instance"},
+			Regions:         []string{"us_east_1"},
+			Tags:            []EntityTag{{Key: "env", Value: "prod"}},
+			FreeText:        "web",
+		},
+		Aggregations: []string{"type"},
+	}
+
+	result, _, err := client.Assets.Search(ctx, searchRequest)
+	if err != nil {
+		t.Fatalf("Assets.Search returned error: %v", err)
+	}
+
+	asset := Asset{
+		ID:             "1",
+		EntityID:       "i-0123456789abcdef0",
+		ExternalID:     "i-0123456789abcdef0",
+		SRL:            "1|123456789012|us_east_1|vpc-12345678|Instance|i-0123456789abcdef0",
+		Name:           "web-1",
+		Type:           "Instance",
+		Platform:       "aws",
+		CloudAccountID: testAccountID,
+		Region:         "us_east_1",
+		Network:        "vpc-12345678",
+		Tags:           []EntityTag{{Key: "env", Value: "prod"}},
+	}
+	expected := &AssetSearchResponse{
+		Assets:       []Asset{asset},
+		TotalCount:   1,
+		SearchAfter:  []string{"1"},
+		Aggregations: map[string][]AggregationValue{"type": {{Value: "Instance", Count: 1}}},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Assets.Search\n got=%#v\nwant=%#v", result, expected)
+	}
+
+	expectedMetadata := &LocationConventionMetadata{
+		SRL:        "1|123456789012|us_east_1|vpc-12345678|Instance|i-0123456789abcdef0",
+		Name:       "web-1",
+		ID:         "i-0123456789abcdef0",
+		ExternalID: "i-0123456789abcdef0",
+	}
+	if got := result.Assets[0].LocationConventionMetadata(); !reflect.DeepEqual(got, expectedMetadata) {
+		t.Errorf("Asset.LocationConventionMetadata\n got=%#v\nwant=%#v", got, expectedMetadata)
+	}
+}
+
+func TestAssets_Iterate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := []string{
+		`{"assets": [{"id": "1"}, {"id": "2"}], "totalCount": 3, "searchAfter": ["2"]}`,
+		`{"assets": [{"id": "3"}], "totalCount": 3, "searchAfter": ["3"]}`,
+	}
+	requests := 0
+	mux.HandleFunc("/v2/protected-asset/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var searchRequest AssetSearchRequest
+		json.NewDecoder(r.Body).Decode(&searchRequest)
+		if requests > 0 && len(searchRequest.SearchAfter) == 0 {
+			t.Errorf("Assets.Iterate request %d without searchAfter", requests)
+		}
+		fmt.Fprint(w, pages[requests])
+		requests++
+	})
+
+	it := client.Assets.Iterate(ctx, &AssetSearchRequest{PageSize: 2})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Asset().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Assets.Iterate returned error: %v", err)
+	}
+
+	if expected := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Assets.Iterate\n got=%v\nwant=%v", ids, expected)
+	}
+	if requests != 2 {
+		t.Errorf("Assets.Iterate made %d requests, expected 2", requests)
+	}
+}
+
+func TestAssets_Iterate_nil(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/protected-asset/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{}`)
+		fmt.Fprint(w, `{"assets": [{"id": "1"}], "totalCount": 1}`)
+	})
+
+	it := client.Assets.Iterate(ctx, nil)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Asset().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Assets.Iterate returned error: %v", err)
+	}
+
+	if expected := []string{"1"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Assets.Iterate\n got=%v\nwant=%v", ids, expected)
+	}
+}
@@ -0,0 +1,220 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const auditTrailBasePath = "v2/AuditTrail"
+
+// AuditTrailService resource has methods to search the audit trail of the
+// changes made to the Dome9 account.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-AuditTrail
+type AuditTrailService interface {
+	Search(context.Context, *AuditTrailSearchRequest) (*AuditTrailSearchResponse, *http.Response, error)
+	Iterate(context.Context, *AuditTrailSearchRequest) *AuditEventIterator
+	Stream(context.Context, *AuditTrailSearchRequest) (<-chan AuditEvent, <-chan error)
+}
+
+// AuditTrailServiceOp handles communication with the Audit Trail
+// related methods of the Dome9 API.
+type AuditTrailServiceOp struct {
+	client *Client
+}
+
+var _ AuditTrailService = &AuditTrailServiceOp{}
+
+// AuditTrailSearchRequest is an audit trail search. Pages after the first one
+// are requested by setting Cursor to the NextCursor returned with the previous
+// page.
+type AuditTrailSearchRequest struct {
+	PageSize int               `json:"pageSize,omitempty"`
+	Sorting  *SearchSorting    `json:"sorting,omitempty"`
+	Filter   *AuditTrailFilter `json:"filter,omitempty"`
+	Cursor   string            `json:"cursor,omitempty"`
+}
+
+// AuditTrailFilter filters the results of an audit trail search. An event is
+// returned if, for every filter set, it has one of the values listed.
+type AuditTrailFilter struct {
+	Users       []string
+	EventTypes  []string
+	EntityTypes []string
+	EntityIDs   []string
+	FreeText    string
+
+	// Time range of the events, ignored when both are zero. To defaults to
+	// the current time.
+	From time.Time
+	To   time.Time
+
+	// Additional filters on fields not covered above.
+	Fields []SearchFilterField
+}
+
+// MarshalJSON encodes the filter as the field list expected by the API.
+func (f AuditTrailFilter) MarshalJSON() ([]byte, error) {
+	filter := struct {
+		searchFilter
+		EventTime *searchTimeRange `json:"eventTime,omitempty"`
+	}{
+		searchFilter: searchFilter{FreeTextPhrase: f.FreeText},
+		EventTime:    newSearchTimeRange(f.From, f.To),
+	}
+
+	filter.addField("user", f.Users...)
+	filter.addField("eventType", f.EventTypes...)
+	filter.addField("entityType", f.EntityTypes...)
+	filter.addField("entityId", f.EntityIDs...)
+	filter.Fields = append(filter.Fields, f.Fields...)
+
+	return json.Marshal(filter)
+}
+
+// AuditTrailSearchResponse is a page of audit trail search results.
+type AuditTrailSearchResponse struct {
+	Events     []AuditEvent `json:"events"`
+	TotalCount int          `json:"totalCount"`
+	NextCursor string       `json:"nextCursor"`
+}
+
+// AuditEvent is a change made to the Dome9 account. Data holds the details of
+// the change, which depend on the type of the event.
+type AuditEvent struct {
+	ID             string          `json:"id"`
+	EventTime      Timestamp       `json:"eventTime"`
+	User           string          `json:"user"`
+	AccountID      int64           `json:"accountId"`
+	EventType      string          `json:"eventType"`
+	EntityType     string          `json:"entityType"`
+	EntityID       string          `json:"entityId"`
+	EntityName     string          `json:"entityName"`
+	CloudAccountID string          `json:"cloudAccountId"`
+	Platform       string          `json:"platform"`
+	SourceIP       string          `json:"sourceIp"`
+	Message        string          `json:"message"`
+	Data           json.RawMessage `json:"data,omitempty"`
+}
+
+// Search the audit trail. Only a page of results is returned, use Iterate or
+// Stream to walk all of them.
+func (s *AuditTrailServiceOp) Search(ctx context.Context, searchRequest *AuditTrailSearchRequest) (*AuditTrailSearchResponse, *http.Response, error) {
+	path := fmt.Sprintf("%s/search", auditTrailBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, searchRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(AuditTrailSearchResponse)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// Iterate returns an iterator over the events matching searchRequest, all of
+// them when it is nil.
+func (s *AuditTrailServiceOp) Iterate(ctx context.Context, searchRequest *AuditTrailSearchRequest) *AuditEventIterator {
+	if searchRequest == nil {
+		searchRequest = &AuditTrailSearchRequest{}
+	}
+
+	request := *searchRequest
+	it := new(AuditEventIterator)
+	it.fetch = func() ([]interface{}, bool, error) {
+		result, _, err := s.Search(ctx, &request)
+		if err != nil {
+			return nil, false, err
+		}
+
+		request.Cursor = result.NextCursor
+		page := make([]interface{}, len(result.Events))
+		for i, event := range result.Events {
+			page[i] = event
+		}
+
+		return page, result.NextCursor == "", nil
+	}
+
+	return it
+}
+
+// Stream sends all the events matching searchRequest on the returned events
+// channel, fetching the pages of results as needed. The events channel is
+// closed when all events were sent, or the search failed or ctx was done,
+// after which the error, if any, is sent on the error channel. A nil
+// searchRequest streams all events.
+//
+// To stop reading before the events channel is closed, cancel ctx: Stream
+// keeps waiting to send the next event until it is received or ctx is done.
+//
+//	events, errc := client.AuditTrail.Stream(ctx, searchRequest)
+//	for event := range events {
+//		...
+//	}
+//	if err := <-errc; err != nil {
+//		...
+//	}
+func (s *AuditTrailServiceOp) Stream(ctx context.Context, searchRequest *AuditTrailSearchRequest) (<-chan AuditEvent, <-chan error) {
+	events := make(chan AuditEvent)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+		defer close(events)
+
+		it := s.Iterate(ctx, searchRequest)
+		for it.Next() {
+			select {
+			case events <- it.Event():
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			errc <- err
+		}
+	}()
+
+	return events, errc
+}
+
+// AuditEventIterator iterates over the results of an audit trail search,
+// fetching the pages of results as needed.
+//
+//	it := client.AuditTrail.Iterate(ctx, searchRequest)
+//	for it.Next() {
+//		event := it.Event()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type AuditEventIterator struct {
+	pager
+}
+
+// Next advances the iterator to the next event, which is then available
+// through Event. It returns false when there are no more events or an error
+// occurred.
+func (it *AuditEventIterator) Next() bool {
+	return it.next()
+}
+
+// Event returns the current event.
+func (it *AuditEventIterator) Event() AuditEvent {
+	event, _ := it.current.(AuditEvent)
+	return event
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AuditEventIterator) Err() error {
+	return it.err
+}
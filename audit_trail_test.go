@@ -0,0 +1,176 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAuditTrail_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AuditTrail/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"pageSize":100,"filter":{"fields":[{"name":"user","value":"user@example.com"},{"name":"eventType","value":"Update"},{"name":"entityType","value":"Ruleset"},{"name":"entityId","value":"1337"}],"eventTime":{"from":"2018-08-01T00:00:00Z","to":"2018-09-01T00:00:00Z"}}}`)
+		fmt.Fprint(w, `{
+  "events": [
+    {
+      "id": "1",
+      "eventTime": "2018-08-26T16:11:12Z",
+      "user": "user@example.com",
+      "accountId": 1337,
+      "eventType": "Update",
+      "entityType": "Ruleset",
+      "entityId": "1337",
+      "entityName": "string",
+      "cloudAccountId": "",
+      "platform": "",
+      "sourceIp": "10.0.0.1",
+      "message": "string",
+      "data": {"name": "string"}
+    }
+  ],
+  "totalCount": 1,
+  "nextCursor": ""
+}`)
+	})
+
+	searchRequest := &AuditTrailSearchRequest{
+		PageSize: 100,
+		Filter: &AuditTrailFilter{
+			Users:       []string{"user@example.com"},
+			EventTypes:  []string{"Update"},
+			EntityTypes: []string{"Ruleset"},
+			EntityIDs:   []string{"1337"},
+			From:        time.Date(2018, 8, 1, 0, 0, 0, 0, time.UTC),
+			To:          time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	result, _, err := client.AuditTrail.Search(ctx, searchRequest)
+	if err != nil {
+		t.Errorf("AuditTrail.Search returned error: %v", err)
+	}
+
+	expected := &AuditTrailSearchResponse{
+		Events: []AuditEvent{{
+			ID:         "1",
+			EventTime:  testTimestamp,
+			User:       "user@example.com",
+			AccountID:  1337,
+			EventType:  "Update",
+			EntityType: "Ruleset",
+			EntityID:   "1337",
+			EntityName: "string",
+			SourceIP:   "10.0.0.1",
+			Message:    "string",
+			Data:       json.RawMessage(`{"name": "string"}`),
+		}},
+		TotalCount: 1,
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("AuditTrail.Search\n got=%#v\nwant=%#v", result, expected)
+	}
+}
+
+func testAuditTrailPages(t *testing.T) *int {
+	pages := []string{
+		`{"events": [{"id": "1"}, {"id": "2"}], "totalCount": 3, "nextCursor": "a"}`,
+		`{"events": [{"id": "3"}], "totalCount": 3, "nextCursor": ""}`,
+	}
+	requests := 0
+	mux.HandleFunc("/v2/AuditTrail/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var searchRequest AuditTrailSearchRequest
+		json.NewDecoder(r.Body).Decode(&searchRequest)
+		if requests > 0 && searchRequest.Cursor != "a" {
+			t.Errorf("AuditTrail request %d with cursor %q", requests, searchRequest.Cursor)
+		}
+		fmt.Fprint(w, pages[requests])
+		requests++
+	})
+
+	return &requests
+}
+
+func TestAuditTrail_Iterate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := testAuditTrailPages(t)
+
+	it := client.AuditTrail.Iterate(ctx, &AuditTrailSearchRequest{})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Event().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("AuditTrail.Iterate returned error: %v", err)
+	}
+
+	if expected := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("AuditTrail.Iterate\n got=%v\nwant=%v", ids, expected)
+	}
+	if *requests != 2 {
+		t.Errorf("AuditTrail.Iterate made %d requests, expected 2", *requests)
+	}
+}
+
+func TestAuditTrail_Stream(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAuditTrailPages(t)
+
+	events, errc := client.AuditTrail.Stream(ctx, nil)
+	var ids []string
+	for event := range events {
+		ids = append(ids, event.ID)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("AuditTrail.Stream returned error: %v", err)
+	}
+
+	if expected := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("AuditTrail.Stream\n got=%v\nwant=%v", ids, expected)
+	}
+}
+
+func TestAuditTrail_Stream_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAuditTrailPages(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errc := client.AuditTrail.Stream(ctx, &AuditTrailSearchRequest{})
+	<-events
+	cancel()
+
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("AuditTrail.Stream error = %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestAuditTrail_Stream_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AuditTrail/search", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	events, errc := client.AuditTrail.Stream(ctx, &AuditTrailSearchRequest{})
+	for range events {
+		t.Errorf("AuditTrail.Stream expected no events")
+	}
+	if err := <-errc; err == nil {
+		t.Errorf("AuditTrail.Stream expected error")
+	}
+}
@@ -0,0 +1,286 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const awsCloudAccountBasePath = "v2/CloudAccounts"
+
+// AwsCloudAccountsService is an interface for interfacing with the
+// CloudAccounts endpoints of the Dome9 API.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-CloudAccounts
+type AwsCloudAccountsService interface {
+	List(context.Context) ([]AwsCloudAccount, *http.Response, error)
+	Get(context.Context, string) (*AwsCloudAccount, *http.Response, error)
+	Create(context.Context, AwsCloudAccount) (*AwsCloudAccount, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+	UpdateAccountName(context.Context, string, AwsAccountNameMode) (*AwsCloudAccount, *http.Response, error)
+	UpdateCredentials(context.Context, string, AwsAccountCredentials) (*AwsCloudAccount, *http.Response, error)
+	UpdateRegionConfig(context.Context, string, AwsAccountRegionConfig) (*AwsCloudAccount, *http.Response, error)
+	UpdateOrganizationalUnit(context.Context, string, CloudAccountOrganizationalUnit) (*AwsCloudAccount, *http.Response, error)
+	GetMissingPermissions(context.Context, string) (*CloudAccountMissingPermissions, *http.Response, error)
+	GetMissingPermissionsByEntityType(context.Context, string, string, string) ([]MissingPermission, *http.Response, error)
+	ResetMissingPermissions(context.Context, string) (*http.Response, error)
+}
+
+// AwsCloudAccountsServiceOp handles communication with the CloudAccounts
+// related methods of the Dome9 API.
+type AwsCloudAccountsServiceOp struct {
+	client *Client
+}
+
+var _ AwsCloudAccountsService = &AwsCloudAccountsServiceOp{}
+
+// Types of credentials used to access an AWS account.
+const (
+	AwsCredentialsRoleBased = "RoleBased"
+	AwsCredentialsUserBased = "UserBased"
+)
+
+// Behaviors Dome9 applies to new security groups in an AWS region.
+const (
+	AwsNewGroupBehaviorReadOnly   = "ReadOnly"
+	AwsNewGroupBehaviorFullManage = "FullManage"
+	AwsNewGroupBehaviorReset      = "Reset"
+)
+
+// AwsAccountCredentials are the credentials used to access an AWS account.
+// For role based credentials Arn is the role ARN and Secret the external ID.
+type AwsAccountCredentials struct {
+	APIKey     string `json:"apikey,omitempty"`
+	Arn        string `json:"arn,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	IamUser    string `json:"iamUser,omitempty"`
+	Type       string `json:"type"`
+	IsReadOnly bool   `json:"isReadOnly"`
+}
+
+// NewAwsRoleCredentials returns role based credentials for the role roleArn,
+// assumed with externalID.
+func NewAwsRoleCredentials(roleArn, externalID string) *AwsAccountCredentials {
+	return &AwsAccountCredentials{Arn: roleArn, Secret: externalID, Type: AwsCredentialsRoleBased}
+}
+
+// AwsCloudAccount are the details of an AWS account.
+type AwsCloudAccount struct {
+	ID                     string                 `json:"id,omitempty"`
+	Vendor                 string                 `json:"vendor,omitempty"`
+	Name                   string                 `json:"name"`
+	ExternalAccountNumber  string                 `json:"externalAccountNumber"`
+	Error                  string                 `json:"error,omitempty"`
+	IsFetchingSuspended    bool                   `json:"isFetchingSuspended"`
+	CreationDate           *Timestamp             `json:"creationDate,omitempty"`
+	Credentials            *AwsAccountCredentials `json:"credentials"`
+	NetSec                 *AwsAccountNetSec      `json:"netSec,omitempty"`
+	Magellan               bool                   `json:"magellan"`
+	FullProtection         bool                   `json:"fullProtection"`
+	AllowReadOnly          bool                   `json:"allowReadOnly"`
+	OrganizationalUnitID   string                 `json:"organizationalUnitId,omitempty"`
+	OrganizationalUnitPath string                 `json:"organizationalUnitPath,omitempty"`
+	OrganizationalUnitName string                 `json:"organizationalUnitName,omitempty"`
+}
+
+// AwsAccountNetSec is the network security configuration of an AWS account.
+type AwsAccountNetSec struct {
+	Regions []AwsAccountRegionConfig `json:"regions"`
+}
+
+// AwsAccountRegionConfig is the configuration of an AWS region in Dome9.
+type AwsAccountRegionConfig struct {
+	Region           string `json:"region"`
+	Name             string `json:"name,omitempty"`
+	Hidden           bool   `json:"hidden"`
+	NewGroupBehavior string `json:"newGroupBehavior"`
+}
+
+// AwsAccountNameMode is used to create the JSON object to update an AWS Account Name.
+type AwsAccountNameMode struct {
+	Name string `json:"name"`
+}
+
+// awsCloudAccountUpdate is the JSON object used by the CloudAccounts update endpoints.
+type awsCloudAccountUpdate struct {
+	CloudAccountID string      `json:"cloudAccountId"`
+	Data           interface{} `json:"data"`
+}
+
+// List all AwsCloudAccounts.
+func (s *AwsCloudAccountsServiceOp) List(ctx context.Context) ([]AwsCloudAccount, *http.Response, error) {
+	path := awsCloudAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var awsAccounts []AwsCloudAccount
+	resp, err := s.client.Do(ctx, req, &awsAccounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return awsAccounts, resp, err
+}
+
+// Get an AWS account by its Dome9 ID or its AWS account number.
+func (s *AwsCloudAccountsServiceOp) Get(ctx context.Context, accountID string) (*AwsCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", awsCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	awsAccount := new(AwsCloudAccount)
+	resp, err := s.client.Do(ctx, req, awsAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return awsAccount, resp, err
+}
+
+// Create (onboard) an AWS account to the user's Dome9 account.
+func (s *AwsCloudAccountsServiceOp) Create(ctx context.Context, awsAccount AwsCloudAccount) (*AwsCloudAccount, *http.Response, error) {
+	path := awsCloudAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, awsAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AwsCloudAccount)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Delete an AWS account from a Dome9 account (the AWS account is not deleted from AWS).
+func (s *AwsCloudAccountsServiceOp) Delete(ctx context.Context, accountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", awsCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
+
+// UpdateAccountName changes the account name (in Dome9) for an AWS account.
+func (s *AwsCloudAccountsServiceOp) UpdateAccountName(ctx context.Context, accountID string, accountName AwsAccountNameMode) (*AwsCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/name", awsCloudAccountBasePath)
+
+	return s.update(ctx, path, awsCloudAccountUpdate{CloudAccountID: accountID, Data: accountName.Name})
+}
+
+// UpdateCredentials changes the credentials Dome9 uses to access an AWS account.
+func (s *AwsCloudAccountsServiceOp) UpdateCredentials(ctx context.Context, accountID string, credentials AwsAccountCredentials) (*AwsCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/credentials", awsCloudAccountBasePath)
+
+	return s.update(ctx, path, awsCloudAccountUpdate{CloudAccountID: accountID, Data: credentials})
+}
+
+// UpdateRegionConfig changes the configuration of a region of an AWS account. The
+// NewGroupBehavior of a region can be ReadOnly, FullManage or Reset.
+func (s *AwsCloudAccountsServiceOp) UpdateRegionConfig(ctx context.Context, accountID string, regionConfig AwsAccountRegionConfig) (*AwsCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/region-conf", awsCloudAccountBasePath)
+
+	return s.update(ctx, path, awsCloudAccountUpdate{CloudAccountID: accountID, Data: regionConfig})
+}
+
+// UpdateOrganizationalUnit moves an AWS account to an Organizational Unit.
+func (s *AwsCloudAccountsServiceOp) UpdateOrganizationalUnit(ctx context.Context, accountID string, organizationalUnit CloudAccountOrganizationalUnit) (*AwsCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/organizationalUnit", awsCloudAccountBasePath, accountID)
+
+	return s.update(ctx, path, organizationalUnit)
+}
+
+func (s *AwsCloudAccountsServiceOp) update(ctx context.Context, path string, body interface{}) (*AwsCloudAccount, *http.Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	awsAccount := new(AwsCloudAccount)
+	resp, err := s.client.Do(ctx, req, awsAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return awsAccount, resp, err
+}
+
+// GetMissingPermissions lists missing permissions for an AWS account in a Dome9 account.
+func (s *AwsCloudAccountsServiceOp) GetMissingPermissions(ctx context.Context, accountID string) (*CloudAccountMissingPermissions, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions", awsCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missingPerms := new(CloudAccountMissingPermissions)
+	resp, err := s.client.Do(ctx, req, missingPerms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return missingPerms, resp, err
+}
+
+// GetMissingPermissionsByEntityType lists missing permissions for a specific cloud entity type and AWS cloud account.
+func (s *AwsCloudAccountsServiceOp) GetMissingPermissionsByEntityType(ctx context.Context, accountID, entityType, subType string) ([]MissingPermission, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions/EntityType?entityType=%s&subType=%s", awsCloudAccountBasePath, accountID, entityType, subType)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var missingPerms []MissingPermission
+	resp, err := s.client.Do(ctx, req, &missingPerms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return missingPerms, resp, err
+}
+
+// ResetMissingPermissions resets (re-validate) the missing permissions indication for an AWS account in Dome9.
+func (s *AwsCloudAccountsServiceOp) ResetMissingPermissions(ctx context.Context, accountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions/Reset", awsCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// ResetMissingPermissions returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+	return resp, err
+}
@@ -0,0 +1,259 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAwsCloudAccounts_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "vendor": "aws",
+    "name": "string",
+    "externalAccountNumber": "123456789012",
+    "error": "string",
+    "isFetchingSuspended": false,
+    "creationDate": "2018-08-26T16:11:12Z",
+    "credentials": {
+      "apikey": "string",
+      "arn": "arn:aws:iam::123456789012:role/Dome9-Connect",
+      "secret": "string",
+      "iamUser": "string",
+      "type": "RoleBased",
+      "isReadOnly": true
+    },
+    "netSec": {
+      "regions": [
+        {
+          "region": "us_east_1",
+          "name": "N. Virginia",
+          "hidden": false,
+          "newGroupBehavior": "ReadOnly"
+        }
+      ]
+    },
+    "magellan": true,
+    "fullProtection": false,
+    "allowReadOnly": true,
+    "organizationalUnitId": "00000000-0000-0000-0000-000000000000",
+    "organizationalUnitPath": "string",
+    "organizationalUnitName": "string"
+  }
+]`)
+	})
+
+	awsAccounts, _, err := client.AwsCloudAccounts.List(ctx)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.List returned error: %v", err)
+	}
+
+	expected := []AwsCloudAccount{{ID: "00000000-0000-0000-0000-000000000000", Vendor: "aws", Name: "string", ExternalAccountNumber: "123456789012", Error: "string", CreationDate: &testTimestamp, Credentials: &AwsAccountCredentials{APIKey: "string", Arn: "arn:aws:iam::123456789012:role/Dome9-Connect", Secret: "string", IamUser: "string", Type: AwsCredentialsRoleBased, IsReadOnly: true}, NetSec: &AwsAccountNetSec{Regions: []AwsAccountRegionConfig{{Region: "us_east_1", Name: "N. Virginia", NewGroupBehavior: AwsNewGroupBehaviorReadOnly}}}, Magellan: true, AllowReadOnly: true, OrganizationalUnitID: "00000000-0000-0000-0000-000000000000", OrganizationalUnitPath: "string", OrganizationalUnitName: "string"}}
+
+	if !reflect.DeepEqual(awsAccounts, expected) {
+		t.Errorf("AwsCloudAccounts.List\n got=%#v\nwant=%#v", awsAccounts, expected)
+	}
+}
+
+func TestAwsCloudAccounts_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/123456789012", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "00000000-0000-0000-0000-000000000000",
+  "vendor": "aws",
+  "name": "string",
+  "externalAccountNumber": "123456789012",
+  "credentials": {
+    "arn": "arn:aws:iam::123456789012:role/Dome9-Connect",
+    "type": "RoleBased"
+  }
+}`)
+	})
+
+	awsAccount, _, err := client.AwsCloudAccounts.Get(ctx, "123456789012")
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.Get returned error: %v", err)
+	}
+
+	expected := &AwsCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Vendor: "aws", Name: "string", ExternalAccountNumber: "123456789012", Credentials: &AwsAccountCredentials{Arn: "arn:aws:iam::123456789012:role/Dome9-Connect", Type: AwsCredentialsRoleBased}}
+
+	if !reflect.DeepEqual(awsAccount, expected) {
+		t.Errorf("AwsCloudAccounts.Get\n got=%#v\nwant=%#v", awsAccount, expected)
+	}
+}
+
+func TestAwsCloudAccounts_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"string","externalAccountNumber":"","isFetchingSuspended":false,"credentials":{"arn":"arn:aws:iam::123456789012:role/Dome9-Connect","secret":"extid","type":"RoleBased","isReadOnly":false},"magellan":false,"fullProtection":false,"allowReadOnly":false}`)
+		fmt.Fprint(w, `{"id": "00000000-0000-0000-0000-000000000000", "vendor": "aws", "name": "string"}`)
+	})
+
+	awsAccount := AwsCloudAccount{Name: "string", Credentials: NewAwsRoleCredentials("arn:aws:iam::123456789012:role/Dome9-Connect", "extid")}
+
+	created, _, err := client.AwsCloudAccounts.Create(ctx, awsAccount)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.Create returned error: %v", err)
+	}
+
+	if expected := (&AwsCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Vendor: "aws", Name: "string"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("AwsCloudAccounts.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestAwsCloudAccounts_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/"+testAccountID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwsCloudAccounts.Delete(ctx, testAccountID)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.Delete returned error: %v", err)
+	}
+}
+
+func TestAwsCloudAccounts_UpdateAccountName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/name", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"cloudAccountId":"`+testAccountID+`","data":"foobared"}`)
+		fmt.Fprint(w, `{"id": "`+testAccountID+`", "name": "foobared"}`)
+	})
+
+	awsAccount, _, err := client.AwsCloudAccounts.UpdateAccountName(ctx, testAccountID, AwsAccountNameMode{Name: "foobared"})
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.UpdateAccountName returned error: %v", err)
+	}
+
+	if expected := (&AwsCloudAccount{ID: testAccountID, Name: "foobared"}); !reflect.DeepEqual(awsAccount, expected) {
+		t.Errorf("AwsCloudAccounts.UpdateAccountName\n got=%#v\nwant=%#v", awsAccount, expected)
+	}
+}
+
+func TestAwsCloudAccounts_UpdateCredentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/credentials", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"cloudAccountId":"`+testAccountID+`","data":{"arn":"arn","secret":"extid","type":"RoleBased","isReadOnly":false}}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.AwsCloudAccounts.UpdateCredentials(ctx, testAccountID, *NewAwsRoleCredentials("arn", "extid"))
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.UpdateCredentials returned error: %v", err)
+	}
+}
+
+func TestAwsCloudAccounts_UpdateRegionConfig(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/region-conf", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"cloudAccountId":"`+testAccountID+`","data":{"region":"us_east_1","hidden":false,"newGroupBehavior":"FullManage"}}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	regionConfig := AwsAccountRegionConfig{Region: "us_east_1", NewGroupBehavior: AwsNewGroupBehaviorFullManage}
+	_, _, err := client.AwsCloudAccounts.UpdateRegionConfig(ctx, testAccountID, regionConfig)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.UpdateRegionConfig returned error: %v", err)
+	}
+}
+
+func TestAwsCloudAccounts_UpdateOrganizationalUnit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/"+testAccountID+"/organizationalUnit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"organizationalUnitId":"ou"}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.AwsCloudAccounts.UpdateOrganizationalUnit(ctx, testAccountID, CloudAccountOrganizationalUnit{OrganizationalUnitID: "ou"})
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.UpdateOrganizationalUnit returned error: %v", err)
+	}
+}
+
+func TestAwsCloudAccounts_GetMissingPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/"+testAccountID+"/MissingPermissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": "00000000-0000-0000-0000-000000000000", "actions": []}`)
+	})
+
+	missingPerms, _, err := client.AwsCloudAccounts.GetMissingPermissions(ctx, testAccountID)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.GetMissingPermissions returned error: %v", err)
+	}
+
+	expected := &CloudAccountMissingPermissions{ID: "00000000-0000-0000-0000-000000000000", Actions: []CloudAccountExternalActionStatus{}}
+
+	if !reflect.DeepEqual(missingPerms, expected) {
+		t.Errorf("AwsCloudAccounts.GetMissingPermissions\n got=%#v\nwant=%#v", missingPerms, expected)
+	}
+}
+
+func TestAwsCloudAccounts_GetMissingPermissionsByEntityType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/"+testAccountID+"/MissingPermissions/EntityType", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if q := r.URL.Query(); q.Get("entityType") != "entType" || q.Get("subType") != "subType" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `[{"srl": "string", "vendor": "aws"}]`)
+	})
+
+	missingPerms, _, err := client.AwsCloudAccounts.GetMissingPermissionsByEntityType(ctx, testAccountID, "entType", "subType")
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.GetMissingPermissionsByEntityType returned error: %v", err)
+	}
+
+	expected := []MissingPermission{{Srl: "string", Vendor: "aws"}}
+
+	if !reflect.DeepEqual(missingPerms, expected) {
+		t.Errorf("AwsCloudAccounts.GetMissingPermissionsByEntityType\n got=%#v\nwant=%#v", missingPerms, expected)
+	}
+}
+
+func TestAwsCloudAccounts_ResetMissingPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudAccounts/"+testAccountID+"/MissingPermissions/Reset", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwsCloudAccounts.ResetMissingPermissions(ctx, testAccountID)
+	if err != nil {
+		t.Errorf("AwsCloudAccounts.ResetMissingPermissions returned error: %v", err)
+	}
+}
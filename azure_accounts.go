@@ -20,6 +20,7 @@ type AzureCloudAccountsService interface {
 	ResetMissingPermissions(context.Context, string) (*http.Response, error)
 	UpdateOperationMode(context.Context, string, AzureAccountOperationMode) (*AzureCloudAccount, *http.Response, error)
 	UpdateAccountName(context.Context, string, AzureAccountNameMode) (*AzureCloudAccount, *http.Response, error)
+	UpdateOrganizationalUnit(context.Context, string, CloudAccountOrganizationalUnit) (*AzureCloudAccount, *http.Response, error)
 }
 
 // AzureCloudAccountsServiceOp handles communication with the AzureCloudAccount
@@ -38,14 +39,17 @@ type AzureAccountCredentials struct {
 
 // AzureCloudAccount are the details of an Azure account.
 type AzureCloudAccount struct {
-	ID             string                   `json:"id"`
-	Name           string                   `json:"name"`
-	SubscriptionID string                   `json:"subscriptionId"`
-	TenantID       string                   `json:"tenantID"`
-	Credentials    *AzureAccountCredentials `json:"credentials"`
-	OperationMode  string                   `json:"operationMode"`
-	Error          string                   `json:"error"`
-	CreationDate   string                   `json:"creationDate"`
+	ID                     string                   `json:"id"`
+	Name                   string                   `json:"name"`
+	SubscriptionID         string                   `json:"subscriptionId"`
+	TenantID               string                   `json:"tenantID"`
+	Credentials            *AzureAccountCredentials `json:"credentials"`
+	OperationMode          string                   `json:"operationMode"`
+	Error                  string                   `json:"error"`
+	CreationDate           *Timestamp               `json:"creationDate,omitempty"`
+	OrganizationalUnitID   string                   `json:"organizationalUnitId,omitempty"`
+	OrganizationalUnitPath string                   `json:"organizationalUnitPath,omitempty"`
+	OrganizationalUnitName string                   `json:"organizationalUnitName,omitempty"`
 }
 
 // AzureAccountOperationMode is the operations mode for an Azure account in Dome9. Modes can be Read-Only or Manage.
@@ -215,3 +219,21 @@ func (s *AzureCloudAccountsServiceOp) UpdateAccountName(ctx context.Context, acc
 
 	return azureAccount, resp, err
 }
+
+// UpdateOrganizationalUnit moves an Azure account to an Organizational Unit.
+func (s *AzureCloudAccountsServiceOp) UpdateOrganizationalUnit(ctx context.Context, accountID string, organizationalUnit CloudAccountOrganizationalUnit) (*AzureCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/organizationalUnit", azureCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, organizationalUnit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	azureAccount := new(AzureCloudAccount)
+	resp, err := s.client.Do(ctx, req, azureAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return azureAccount, resp, err
+}
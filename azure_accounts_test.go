@@ -37,7 +37,7 @@ func TestAzureCloudAccounts_ListAzureCloudAccounts(t *testing.T) {
 		t.Errorf("AzureCloudAccounts.List returned error: %v", err)
 	}
 
-	expected := []AzureCloudAccount{{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: "2018-08-26T16:11:12Z"}}
+	expected := []AzureCloudAccount{{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: &testTimestamp}}
 
 	if !reflect.DeepEqual(azureAccounts, expected) {
 		t.Errorf("AzureCloudAccounts.List\n got=%#v\nwant=%#v", azureAccounts, expected)
@@ -66,10 +66,11 @@ func TestAzureCloudAccounts_CreateAzureCloudAccount(t *testing.T) {
 
 	mux.HandleFunc("/v2/AzureCloudAccount", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"id":"","name":"string","subscriptionId":"string","tenantID":"string","credentials":{"clientId":"string","clientPassword":"string"},"operationMode":"Read","error":""}`)
 		fmt.Fprint(w, `{}`)
 	})
 
-	azureAccount := AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: "2018-08-26T16:11:12Z"}
+	azureAccount := AzureCloudAccount{Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read"}
 
 	_, err := client.AzureCloudAccounts.Create(ctx, azureAccount)
 	if err != nil {
@@ -77,6 +78,26 @@ func TestAzureCloudAccounts_CreateAzureCloudAccount(t *testing.T) {
 	}
 }
 
+func TestAzureCloudAccounts_CreateAzureCloudAccount_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureCloudAccount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Subscription already onboarded","code":"Conflict"}`)
+	})
+
+	_, err := client.AzureCloudAccounts.Create(ctx, AzureCloudAccount{Name: "string"})
+	if !IsConflict(err) {
+		t.Fatalf("AzureCloudAccounts.Create expected conflict error, got: %v", err)
+	}
+
+	if errResp := err.(*ErrorResponse); errResp.Message != "Subscription already onboarded" {
+		t.Errorf("AzureCloudAccounts.Create error message = %q", errResp.Message)
+	}
+}
+
 func TestAzureCloudAccounts_GetAzureCloudAccountsMissingPermissions(t *testing.T) {
 	setup()
 	defer teardown()
@@ -145,7 +166,7 @@ func TestAzureCloudAccounts_GetAzureCloudAccountsMissingPermissionsByEntityType(
 		t.Errorf("AzureCloudAccounts.GetMissingPermissionsByEntityType returned error: %v", err)
 	}
 
-	expected := []MissingPermission{{Srl: "string", ConsecutiveFails: 0, LastFail: "2018-08-26T16:11:12Z", LastSuccess: "2018-08-26T16:11:12Z", FirstFail: "2018-08-26T16:11:12Z", LastFailErrorCode: "string", LastFailMessage: "string", ID: "00000000-0000-0000-0000-000000000000", RetryMetadata: &MissingPermissionMetadata{Permissions: []string{"string"}, EntityType: "string", SubType: "string"}, CloudAccountID: "00000000-0000-0000-0000-000000000000", Vendor: "aws"}}
+	expected := []MissingPermission{{Srl: "string", ConsecutiveFails: 0, LastFail: testTimestamp, LastSuccess: testTimestamp, FirstFail: testTimestamp, LastFailErrorCode: "string", LastFailMessage: "string", ID: "00000000-0000-0000-0000-000000000000", RetryMetadata: &MissingPermissionMetadata{Permissions: []string{"string"}, EntityType: "string", SubType: "string"}, CloudAccountID: "00000000-0000-0000-0000-000000000000", Vendor: "aws"}}
 
 	if !reflect.DeepEqual(missingPerms, expected) {
 		t.Errorf("AzureCloudAccounts.GetMissingPermissionsByEntityType\n got=%#v\nwant=%#v", missingPerms, expected)
@@ -194,7 +215,7 @@ func TestAzureCloudAccounts_UpdateOperationMode(t *testing.T) {
 		t.Errorf("AzureCloudAccounts.UpdateOperationMode returned error: %v", err)
 	}
 
-	expected := &AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: "2018-08-26T16:11:12Z"}
+	expected := &AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: &testTimestamp}
 
 	if !reflect.DeepEqual(azureAccount, expected) {
 		t.Errorf("AzureCloudAccounts.List\n got=%#v\nwant=%#v", azureAccount, expected)
@@ -228,9 +249,37 @@ func TestAzureCloudAccounts_UpdateAccountName(t *testing.T) {
 		t.Errorf("AzureCloudAccounts.UpdateOperationMode returned error: %v", err)
 	}
 
-	expected := &AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: "2018-08-26T16:11:12Z"}
+	expected := &AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", SubscriptionID: "string", TenantID: "string", Credentials: &AzureAccountCredentials{ClientID: "string", ClientPassword: "string"}, OperationMode: "Read", Error: "string", CreationDate: &testTimestamp}
 
 	if !reflect.DeepEqual(azureAccount, expected) {
 		t.Errorf("AzureCloudAccounts.List\n got=%#v\nwant=%#v", azureAccount, expected)
 	}
 }
+
+func TestAzureCloudAccounts_UpdateOrganizationalUnit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureCloudAccount/"+testAccountID+"/organizationalUnit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"organizationalUnitId":"ou"}`)
+		fmt.Fprint(w, `{
+    "id": "00000000-0000-0000-0000-000000000000",
+    "name": "string",
+    "organizationalUnitId": "ou",
+    "organizationalUnitPath": "root/ou",
+    "organizationalUnitName": "ou"
+}`)
+	})
+
+	azureAccount, _, err := client.AzureCloudAccounts.UpdateOrganizationalUnit(ctx, testAccountID, CloudAccountOrganizationalUnit{OrganizationalUnitID: "ou"})
+	if err != nil {
+		t.Errorf("AzureCloudAccounts.UpdateOrganizationalUnit returned error: %v", err)
+	}
+
+	expected := &AzureCloudAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", OrganizationalUnitID: "ou", OrganizationalUnitPath: "root/ou", OrganizationalUnitName: "ou"}
+
+	if !reflect.DeepEqual(azureAccount, expected) {
+		t.Errorf("AzureCloudAccounts.UpdateOrganizationalUnit\n got=%#v\nwant=%#v", azureAccount, expected)
+	}
+}
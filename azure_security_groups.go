@@ -0,0 +1,189 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const azureSecurityGroupsBasePath = "v2/AzureSecurityGroupPolicy"
+
+// AzureSecurityGroupsService resource has methods to manage the Azure network
+// security groups of the Azure accounts onboarded to Dome9.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-AzureSecurityGroupPolicy
+type AzureSecurityGroupsService interface {
+	List(context.Context) ([]AzureSecurityGroup, *http.Response, error)
+	ListByCloudAccounts(context.Context, ...string) ([]AzureSecurityGroup, *http.Response, error)
+	Get(context.Context, string) (*AzureSecurityGroup, *http.Response, error)
+	Create(context.Context, *AzureSecurityGroup) (*AzureSecurityGroup, *http.Response, error)
+	Update(context.Context, string, *AzureSecurityGroup) (*AzureSecurityGroup, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// AzureSecurityGroupsServiceOp handles communication with the Azure Security
+// Groups related methods of the Dome9 API.
+type AzureSecurityGroupsServiceOp struct {
+	client *Client
+}
+
+var _ AzureSecurityGroupsService = &AzureSecurityGroupsServiceOp{}
+
+// Access of an Azure security group rule.
+const (
+	AzureRuleAccessAllow = "Allow"
+	AzureRuleAccessDeny  = "Deny"
+)
+
+// AzureSecurityGroup is an Azure network security group.
+type AzureSecurityGroup struct {
+	ID                 string              `json:"id,omitempty"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description"`
+	Region             string              `json:"region"`
+	ResourceGroup      string              `json:"resourceGroup"`
+	CloudAccountID     string              `json:"cloudAccountId"`
+	CloudAccountName   string              `json:"cloudAccountName,omitempty"`
+	IsTamperProtected  bool                `json:"isTamperProtected"`
+	Tags               []EntityTag         `json:"tags"`
+	InboundServices    []AzureSecurityRule `json:"inboundServices"`
+	OutboundServices   []AzureSecurityRule `json:"outboundServices"`
+	ExternalID         string              `json:"externalSecurityGroupId,omitempty"`
+	LastUpdatedByDome9 bool                `json:"lastUpdatedByDome9,omitempty"`
+}
+
+// AzureSecurityRule is an inbound or outbound rule of an Azure network
+// security group. Rules are evaluated by increasing priority, from 100 to
+// 4096. Scopes can be CIDRs, IP lists or service tags.
+type AzureSecurityRule struct {
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	Priority              int                  `json:"priority"`
+	Access                string               `json:"access"`
+	Protocol              string               `json:"protocol"`
+	Direction             string               `json:"direction"`
+	SourcePortRanges      []string             `json:"sourcePortRanges"`
+	SourceScopes          []SecurityGroupScope `json:"sourceScopes"`
+	DestinationPortRanges []string             `json:"destinationPortRanges"`
+	DestinationScopes     []SecurityGroupScope `json:"destinationScopes"`
+	IsDefault             bool                 `json:"isDefault"`
+}
+
+// List all Azure security groups.
+func (s *AzureSecurityGroupsServiceOp) List(ctx context.Context) ([]AzureSecurityGroup, *http.Response, error) {
+	path := azureSecurityGroupsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var securityGroups []AzureSecurityGroup
+	resp, err := s.client.Do(ctx, req, &securityGroups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return securityGroups, resp, err
+}
+
+// ListByCloudAccounts lists the Azure security groups of the given cloud
+// accounts, by their Dome9 IDs as returned by AzureCloudAccountsService.List.
+// The API has no such filter, so all security groups are fetched and
+// filtered.
+func (s *AzureSecurityGroupsServiceOp) ListByCloudAccounts(ctx context.Context, cloudAccountIDs ...string) ([]AzureSecurityGroup, *http.Response, error) {
+	securityGroups, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ids := make(map[string]bool, len(cloudAccountIDs))
+	for _, id := range cloudAccountIDs {
+		ids[id] = true
+	}
+
+	var filtered []AzureSecurityGroup
+	for _, securityGroup := range securityGroups {
+		if ids[securityGroup.CloudAccountID] {
+			filtered = append(filtered, securityGroup)
+		}
+	}
+
+	return filtered, resp, err
+}
+
+// Get an Azure security group by its ID.
+func (s *AzureSecurityGroupsServiceOp) Get(ctx context.Context, securityGroupID string) (*AzureSecurityGroup, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", azureSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	securityGroup := new(AzureSecurityGroup)
+	resp, err := s.client.Do(ctx, req, securityGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return securityGroup, resp, err
+}
+
+// Create an Azure security group.
+func (s *AzureSecurityGroupsServiceOp) Create(ctx context.Context, securityGroup *AzureSecurityGroup) (*AzureSecurityGroup, *http.Response, error) {
+	path := azureSecurityGroupsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, securityGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AzureSecurityGroup)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update an Azure security group, replacing its rules and tags.
+func (s *AzureSecurityGroupsServiceOp) Update(ctx context.Context, securityGroupID string, securityGroup *AzureSecurityGroup) (*AzureSecurityGroup, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", azureSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, securityGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(AzureSecurityGroup)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete an Azure security group.
+func (s *AzureSecurityGroupsServiceOp) Delete(ctx context.Context, securityGroupID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", azureSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,210 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testAzureSecurityGroupID = "00000000-0000-0000-0000-000000000000"
+
+func TestAzureSecurityGroups_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "name": "web-nsg",
+    "description": "string",
+    "region": "westeurope",
+    "resourceGroup": "web",
+    "cloudAccountId": "1337-acct",
+    "cloudAccountName": "string",
+    "isTamperProtected": true,
+    "tags": [{"key": "env", "value": "prod"}],
+    "inboundServices": [
+      {
+        "name": "https",
+        "description": "string",
+        "priority": 100,
+        "access": "Allow",
+        "protocol": "TCP",
+        "direction": "Inbound",
+        "sourcePortRanges": ["*"],
+        "sourceScopes": [{"type": "Tag", "data": {"name": "Internet"}}],
+        "destinationPortRanges": ["443"],
+        "destinationScopes": [{"type": "CIDR", "data": {"cidr": "10.0.0.0/24", "note": "web"}}],
+        "isDefault": false
+      }
+    ],
+    "outboundServices": [],
+    "externalSecurityGroupId": "/subscriptions/sub/resourceGroups/web/providers/Microsoft.Network/networkSecurityGroups/web-nsg",
+    "lastUpdatedByDome9": true
+  }
+]`)
+	})
+
+	securityGroups, _, err := client.AzureSecurityGroups.List(ctx)
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.List returned error: %v", err)
+	}
+
+	expected := []AzureSecurityGroup{{
+		ID:                testAzureSecurityGroupID,
+		Name:              "web-nsg",
+		Description:       "string",
+		Region:            "westeurope",
+		ResourceGroup:     "web",
+		CloudAccountID:    testAccountID,
+		CloudAccountName:  "string",
+		IsTamperProtected: true,
+		Tags:              []EntityTag{{Key: "env", Value: "prod"}},
+		InboundServices: []AzureSecurityRule{{
+			Name:                  "https",
+			Description:           "string",
+			Priority:              100,
+			Access:                AzureRuleAccessAllow,
+			Protocol:              "TCP",
+			Direction:             SecurityGroupInbound,
+			SourcePortRanges:      []string{"*"},
+			SourceScopes:          []SecurityGroupScope{NewServiceTagScope("Internet")},
+			DestinationPortRanges: []string{"443"},
+			DestinationScopes:     []SecurityGroupScope{NewCIDRScope("10.0.0.0/24", "web")},
+		}},
+		OutboundServices:   []AzureSecurityRule{},
+		ExternalID:         "/subscriptions/sub/resourceGroups/web/providers/Microsoft.Network/networkSecurityGroups/web-nsg",
+		LastUpdatedByDome9: true,
+	}}
+
+	if !reflect.DeepEqual(securityGroups, expected) {
+		t.Errorf("AzureSecurityGroups.List\n got=%#v\nwant=%#v", securityGroups, expected)
+	}
+}
+
+func TestAzureSecurityGroups_ListByCloudAccounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {"id": "1", "cloudAccountId": "a"},
+  {"id": "2", "cloudAccountId": "b"},
+  {"id": "3", "cloudAccountId": "c"}
+]`)
+	})
+
+	securityGroups, _, err := client.AzureSecurityGroups.ListByCloudAccounts(ctx, "a", "c")
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.ListByCloudAccounts returned error: %v", err)
+	}
+
+	expected := []AzureSecurityGroup{{ID: "1", CloudAccountID: "a"}, {ID: "3", CloudAccountID: "c"}}
+
+	if !reflect.DeepEqual(securityGroups, expected) {
+		t.Errorf("AzureSecurityGroups.ListByCloudAccounts\n got=%#v\nwant=%#v", securityGroups, expected)
+	}
+}
+
+func TestAzureSecurityGroups_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy/"+testAzureSecurityGroupID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "`+testAzureSecurityGroupID+`",
+  "name": "web-nsg",
+  "region": "westeurope",
+  "inboundServices": [],
+  "outboundServices": [
+    {
+      "name": "deny-all",
+      "priority": 4096,
+      "access": "Deny",
+      "protocol": "ANY",
+      "direction": "Outbound"
+    }
+  ]
+}`)
+	})
+
+	securityGroup, _, err := client.AzureSecurityGroups.Get(ctx, testAzureSecurityGroupID)
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.Get returned error: %v", err)
+	}
+
+	expected := &AzureSecurityGroup{
+		ID:               testAzureSecurityGroupID,
+		Name:             "web-nsg",
+		Region:           "westeurope",
+		InboundServices:  []AzureSecurityRule{},
+		OutboundServices: []AzureSecurityRule{{Name: "deny-all", Priority: 4096, Access: AzureRuleAccessDeny, Protocol: "ANY", Direction: SecurityGroupOutbound}},
+	}
+
+	if !reflect.DeepEqual(securityGroup, expected) {
+		t.Errorf("AzureSecurityGroups.Get\n got=%#v\nwant=%#v", securityGroup, expected)
+	}
+}
+
+func TestAzureSecurityGroups_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"web-nsg","description":"","region":"westeurope","resourceGroup":"web","cloudAccountId":"`+testAccountID+`","isTamperProtected":false,"tags":null,"inboundServices":null,"outboundServices":null}`)
+		fmt.Fprint(w, `{"id": "`+testAzureSecurityGroupID+`", "name": "web-nsg", "region": "westeurope", "resourceGroup": "web", "cloudAccountId": "`+testAccountID+`"}`)
+	})
+
+	securityGroup := &AzureSecurityGroup{Name: "web-nsg", Region: "westeurope", ResourceGroup: "web", CloudAccountID: testAccountID}
+
+	created, _, err := client.AzureSecurityGroups.Create(ctx, securityGroup)
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.Create returned error: %v", err)
+	}
+
+	if expected := (&AzureSecurityGroup{ID: testAzureSecurityGroupID, Name: "web-nsg", Region: "westeurope", ResourceGroup: "web", CloudAccountID: testAccountID}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("AzureSecurityGroups.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestAzureSecurityGroups_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy/"+testAzureSecurityGroupID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": "`+testAzureSecurityGroupID+`", "name": "web-nsg", "isTamperProtected": true}`)
+	})
+
+	securityGroup := &AzureSecurityGroup{ID: testAzureSecurityGroupID, Name: "web-nsg", IsTamperProtected: true}
+
+	updated, _, err := client.AzureSecurityGroups.Update(ctx, testAzureSecurityGroupID, securityGroup)
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.Update returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, securityGroup) {
+		t.Errorf("AzureSecurityGroups.Update\n got=%#v\nwant=%#v", updated, securityGroup)
+	}
+}
+
+func TestAzureSecurityGroups_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/AzureSecurityGroupPolicy/"+testAzureSecurityGroupID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AzureSecurityGroups.Delete(ctx, testAzureSecurityGroupID)
+	if err != nil {
+		t.Errorf("AzureSecurityGroups.Delete returned error: %v", err)
+	}
+}
@@ -24,9 +24,9 @@ type CloudAccountActionFailure struct {
 type MissingPermission struct {
 	Srl               string                     `json:"srl"`
 	ConsecutiveFails  int32                      `json:"consecutiveFails"`
-	LastFail          string                     `json:"lastFail"`
-	LastSuccess       string                     `json:"lastSuccess"`
-	FirstFail         string                     `json:"firstFail"`
+	LastFail          Timestamp                  `json:"lastFail"`
+	LastSuccess       Timestamp                  `json:"lastSuccess"`
+	FirstFail         Timestamp                  `json:"firstFail"`
 	LastFailErrorCode string                     `json:"lastFailErrorCode"`
 	LastFailMessage   string                     `json:"lastFailMessage"`
 	ID                string                     `json:"id"`
@@ -41,3 +41,8 @@ type MissingPermissionMetadata struct {
 	EntityType  string   `json:"entityType"`
 	SubType     string   `json:"subType"`
 }
+
+// CloudAccountOrganizationalUnit is used to create the JSON object to move a Cloud Account to an Organizational Unit.
+type CloudAccountOrganizationalUnit struct {
+	OrganizationalUnitID string `json:"organizationalUnitId"`
+}
@@ -0,0 +1,257 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const cloudSecurityGroupsBasePath = "v2/CloudSecurityGroup"
+
+// CloudSecurityGroupsService resource has methods to manage AWS security
+// groups through Dome9, which can protect them against changes made outside
+// of it.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-CloudSecurityGroup
+type CloudSecurityGroupsService interface {
+	List(context.Context, string, string) ([]CloudSecurityGroup, *http.Response, error)
+	Get(context.Context, string) (*CloudSecurityGroup, *http.Response, error)
+	Create(context.Context, *CloudSecurityGroup) (*CloudSecurityGroup, *http.Response, error)
+	UpdateService(context.Context, string, string, *SecurityGroupService) (*SecurityGroupService, *http.Response, error)
+	UpdateProtectionMode(context.Context, string, string) (*CloudSecurityGroup, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// CloudSecurityGroupsServiceOp handles communication with the Cloud Security
+// Groups related methods of the Dome9 API.
+type CloudSecurityGroupsServiceOp struct {
+	client *Client
+}
+
+var _ CloudSecurityGroupsService = &CloudSecurityGroupsServiceOp{}
+
+// Protection modes of a security group. Changes made outside of Dome9 to a
+// security group in FullManage mode are reverted.
+const (
+	ProtectionModeFullManage = "FullManage"
+	ProtectionModeReadOnly   = "ReadOnly"
+)
+
+// Directions of the services of a security group.
+const (
+	SecurityGroupInbound  = "Inbound"
+	SecurityGroupOutbound = "Outbound"
+)
+
+// Types of the scopes of a security group service. Service tags are only
+// used by Azure network security groups.
+const (
+	ScopeTypeCIDR          = "CIDR"
+	ScopeTypeIPList        = "IPList"
+	ScopeTypeSecurityGroup = "AWS"
+	ScopeTypeServiceTag    = "Tag"
+)
+
+// CloudSecurityGroup is an AWS security group managed by Dome9.
+type CloudSecurityGroup struct {
+	ID               int64                  `json:"securityGroupId,omitempty"`
+	ExternalID       string                 `json:"externalId,omitempty"`
+	IsProtected      bool                   `json:"isProtected"`
+	Name             string                 `json:"securityGroupName"`
+	Description      string                 `json:"description"`
+	VpcID            string                 `json:"vpcId"`
+	VpcName          string                 `json:"vpcName,omitempty"`
+	RegionID         string                 `json:"regionId"`
+	CloudAccountID   string                 `json:"cloudAccountId"`
+	CloudAccountName string                 `json:"cloudAccountName,omitempty"`
+	Services         *SecurityGroupServices `json:"services,omitempty"`
+	Tags             map[string]string      `json:"tags,omitempty"`
+}
+
+// SecurityGroupServices are the inbound and outbound rules of a security group.
+type SecurityGroupServices struct {
+	Inbound  []SecurityGroupService `json:"inbound"`
+	Outbound []SecurityGroupService `json:"outbound"`
+}
+
+// SecurityGroupService is a rule of a security group, opening a port for the
+// sources or destinations in its scope.
+type SecurityGroupService struct {
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	ProtocolType string               `json:"protocolType"`
+	Port         string               `json:"port"`
+	OpenForAll   bool                 `json:"openForAll"`
+	Scope        []SecurityGroupScope `json:"scope"`
+}
+
+// SecurityGroupScope is a source or destination of a security group service:
+// a CIDR, an IP list or another security group.
+type SecurityGroupScope struct {
+	Type string                 `json:"type"`
+	Data SecurityGroupScopeData `json:"data"`
+}
+
+// SecurityGroupScopeData holds the fields used by the type of the scope: CIDR
+// and Note for CIDRs, ID and Name for IP lists, ExternalID and Name for
+// security groups, Name for service tags.
+type SecurityGroupScopeData struct {
+	CIDR       string `json:"cidr,omitempty"`
+	Note       string `json:"note,omitempty"`
+	ID         int64  `json:"id,omitempty"`
+	ExternalID string `json:"extid,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+// NewCIDRScope returns a scope for a CIDR, e.g. "10.0.0.0/8".
+func NewCIDRScope(cidr, note string) SecurityGroupScope {
+	return SecurityGroupScope{Type: ScopeTypeCIDR, Data: SecurityGroupScopeData{CIDR: cidr, Note: note}}
+}
+
+// NewIPListScope returns a scope for an IP list.
+func NewIPListScope(ipList *IPList) SecurityGroupScope {
+	return SecurityGroupScope{Type: ScopeTypeIPList, Data: SecurityGroupScopeData{ID: ipList.ID, Name: ipList.Name}}
+}
+
+// NewSecurityGroupScope returns a scope for a security group, given its AWS ID
+// (e.g. "sg-0123456789abcdef0") and name.
+func NewSecurityGroupScope(externalID, name string) SecurityGroupScope {
+	return SecurityGroupScope{Type: ScopeTypeSecurityGroup, Data: SecurityGroupScopeData{ExternalID: externalID, Name: name}}
+}
+
+// NewServiceTagScope returns a scope for an Azure service tag, e.g.
+// "VirtualNetwork" or "Internet".
+func NewServiceTagScope(name string) SecurityGroupScope {
+	return SecurityGroupScope{Type: ScopeTypeServiceTag, Data: SecurityGroupScopeData{Name: name}}
+}
+
+type protectionMode struct {
+	ProtectionMode string `json:"protectionMode"`
+}
+
+// List the security groups, optionally only of a cloud account and of a
+// region of it. Empty arguments are ignored.
+func (s *CloudSecurityGroupsServiceOp) List(ctx context.Context, cloudAccountID, regionID string) ([]CloudSecurityGroup, *http.Response, error) {
+	path := cloudSecurityGroupsBasePath
+
+	q := url.Values{}
+	if cloudAccountID != "" {
+		q.Set("cloudAccountId", cloudAccountID)
+	}
+	if regionID != "" {
+		q.Set("regionId", regionID)
+	}
+	if len(q) > 0 {
+		path = fmt.Sprintf("%s?%s", path, q.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var securityGroups []CloudSecurityGroup
+	resp, err := s.client.Do(ctx, req, &securityGroups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return securityGroups, resp, err
+}
+
+// Get a security group by its Dome9 ID or AWS ID.
+func (s *CloudSecurityGroupsServiceOp) Get(ctx context.Context, securityGroupID string) (*CloudSecurityGroup, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", cloudSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	securityGroup := new(CloudSecurityGroup)
+	resp, err := s.client.Do(ctx, req, securityGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return securityGroup, resp, err
+}
+
+// Create a security group.
+func (s *CloudSecurityGroupsServiceOp) Create(ctx context.Context, securityGroup *CloudSecurityGroup) (*CloudSecurityGroup, *http.Response, error) {
+	path := cloudSecurityGroupsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, securityGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(CloudSecurityGroup)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// UpdateService updates a service of a security group, in the
+// SecurityGroupInbound or SecurityGroupOutbound direction.
+func (s *CloudSecurityGroupsServiceOp) UpdateService(ctx context.Context, securityGroupID, direction string, service *SecurityGroupService) (*SecurityGroupService, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/services/%s", cloudSecurityGroupsBasePath, securityGroupID, direction)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, service)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(SecurityGroupService)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// UpdateProtectionMode sets the protection mode of a security group to
+// ProtectionModeFullManage or ProtectionModeReadOnly.
+func (s *CloudSecurityGroupsServiceOp) UpdateProtectionMode(ctx context.Context, securityGroupID, mode string) (*CloudSecurityGroup, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/protection-mode", cloudSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, protectionMode{ProtectionMode: mode})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	securityGroup := new(CloudSecurityGroup)
+	resp, err := s.client.Do(ctx, req, securityGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return securityGroup, resp, err
+}
+
+// Delete a security group.
+func (s *CloudSecurityGroupsServiceOp) Delete(ctx context.Context, securityGroupID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", cloudSecurityGroupsBasePath, securityGroupID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,243 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testSecurityGroupID = "1337"
+
+func TestCloudSecurityGroups_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, expected := r.URL.RawQuery, "cloudAccountId="+testAccountID+"&regionId=us_east_1"; got != expected {
+			t.Errorf("CloudSecurityGroups.List query = %v, expected %v", got, expected)
+		}
+		fmt.Fprint(w, `[
+  {
+    "securityGroupId": 1337,
+    "externalId": "sg-0123456789abcdef0",
+    "isProtected": true,
+    "securityGroupName": "web",
+    "description": "string",
+    "vpcId": "vpc-12345678",
+    "vpcName": "string",
+    "regionId": "us_east_1",
+    "cloudAccountId": "1337-acct",
+    "cloudAccountName": "string",
+    "services": {
+      "inbound": [
+        {
+          "name": "https",
+          "description": "string",
+          "protocolType": "TCP",
+          "port": "443",
+          "openForAll": false,
+          "scope": [
+            {"type": "CIDR", "data": {"cidr": "10.0.0.0/8", "note": "internal"}},
+            {"type": "IPList", "data": {"id": 42, "name": "office"}},
+            {"type": "AWS", "data": {"extid": "sg-0fedcba9876543210", "name": "lb"}}
+          ]
+        }
+      ],
+      "outbound": []
+    },
+    "tags": {"env": "prod"}
+  }
+]`)
+	})
+
+	securityGroups, _, err := client.CloudSecurityGroups.List(ctx, testAccountID, "us_east_1")
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.List returned error: %v", err)
+	}
+
+	expected := []CloudSecurityGroup{{
+		ID:               1337,
+		ExternalID:       "sg-0123456789abcdef0",
+		IsProtected:      true,
+		Name:             "web",
+		Description:      "string",
+		VpcID:            "vpc-12345678",
+		VpcName:          "string",
+		RegionID:         "us_east_1",
+		CloudAccountID:   testAccountID,
+		CloudAccountName: "string",
+		Services: &SecurityGroupServices{
+			Inbound: []SecurityGroupService{{
+				Name:         "https",
+				Description:  "string",
+				ProtocolType: "TCP",
+				Port:         "443",
+				Scope: []SecurityGroupScope{
+					NewCIDRScope("10.0.0.0/8", "internal"),
+					NewIPListScope(&IPList{ID: 42, Name: "office"}),
+					NewSecurityGroupScope("sg-0fedcba9876543210", "lb"),
+				},
+			}},
+			Outbound: []SecurityGroupService{},
+		},
+		Tags: map[string]string{"env": "prod"},
+	}}
+
+	if !reflect.DeepEqual(securityGroups, expected) {
+		t.Errorf("CloudSecurityGroups.List\n got=%#v\nwant=%#v", securityGroups, expected)
+	}
+}
+
+func TestCloudSecurityGroups_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup/"+testSecurityGroupID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "securityGroupId": 1337,
+  "externalId": "sg-0123456789abcdef0",
+  "securityGroupName": "web",
+  "regionId": "us_east_1",
+  "services": {
+    "inbound": [],
+    "outbound": [
+      {
+        "name": "all",
+        "protocolType": "ALL",
+        "openForAll": true
+      }
+    ]
+  }
+}`)
+	})
+
+	securityGroup, _, err := client.CloudSecurityGroups.Get(ctx, testSecurityGroupID)
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.Get returned error: %v", err)
+	}
+
+	expected := &CloudSecurityGroup{
+		ID:         1337,
+		ExternalID: "sg-0123456789abcdef0",
+		Name:       "web",
+		RegionID:   "us_east_1",
+		Services: &SecurityGroupServices{
+			Inbound:  []SecurityGroupService{},
+			Outbound: []SecurityGroupService{{Name: "all", ProtocolType: "ALL", OpenForAll: true}},
+		},
+	}
+
+	if !reflect.DeepEqual(securityGroup, expected) {
+		t.Errorf("CloudSecurityGroups.Get\n got=%#v\nwant=%#v", securityGroup, expected)
+	}
+}
+
+func TestCloudSecurityGroups_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"isProtected":true,"securityGroupName":"web","description":"string","vpcId":"vpc-12345678","regionId":"us_east_1","cloudAccountId":"`+testAccountID+`"}`)
+		fmt.Fprint(w, `{"securityGroupId": 1337, "externalId": "sg-0123456789abcdef0", "securityGroupName": "web"}`)
+	})
+
+	securityGroup := &CloudSecurityGroup{
+		IsProtected:    true,
+		Name:           "web",
+		Description:    "string",
+		VpcID:          "vpc-12345678",
+		RegionID:       "us_east_1",
+		CloudAccountID: testAccountID,
+	}
+
+	created, _, err := client.CloudSecurityGroups.Create(ctx, securityGroup)
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.Create returned error: %v", err)
+	}
+
+	if expected := (&CloudSecurityGroup{ID: 1337, ExternalID: "sg-0123456789abcdef0", Name: "web"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("CloudSecurityGroups.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestCloudSecurityGroups_UpdateService(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup/"+testSecurityGroupID+"/services/Inbound", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"name":"https","description":"string","protocolType":"TCP","port":"443","openForAll":false,"scope":[{"type":"CIDR","data":{"cidr":"10.0.0.0/8","note":"internal"}},{"type":"IPList","data":{"id":42,"name":"office"}},{"type":"AWS","data":{"extid":"sg-0fedcba9876543210","name":"lb"}}]}`)
+		fmt.Fprint(w, `{
+  "name": "https",
+  "description": "string",
+  "protocolType": "TCP",
+  "port": "443",
+  "openForAll": false,
+  "scope": [
+    {"type": "CIDR", "data": {"cidr": "10.0.0.0/8", "note": "internal"}},
+    {"type": "IPList", "data": {"id": 42, "name": "office"}},
+    {"type": "AWS", "data": {"extid": "sg-0fedcba9876543210", "name": "lb"}}
+  ]
+}`)
+	})
+
+	service := &SecurityGroupService{
+		Name:         "https",
+		Description:  "string",
+		ProtocolType: "TCP",
+		Port:         "443",
+		Scope: []SecurityGroupScope{
+			NewCIDRScope("10.0.0.0/8", "internal"),
+			NewIPListScope(&IPList{ID: 42, Name: "office"}),
+			NewSecurityGroupScope("sg-0fedcba9876543210", "lb"),
+		},
+	}
+
+	updated, _, err := client.CloudSecurityGroups.UpdateService(ctx, testSecurityGroupID, SecurityGroupInbound, service)
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.UpdateService returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, service) {
+		t.Errorf("CloudSecurityGroups.UpdateService\n got=%#v\nwant=%#v", updated, service)
+	}
+}
+
+func TestCloudSecurityGroups_UpdateProtectionMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup/"+testSecurityGroupID+"/protection-mode", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"protectionMode":"FullManage"}`)
+		fmt.Fprint(w, `{"securityGroupId": 1337, "isProtected": true}`)
+	})
+
+	securityGroup, _, err := client.CloudSecurityGroups.UpdateProtectionMode(ctx, testSecurityGroupID, ProtectionModeFullManage)
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.UpdateProtectionMode returned error: %v", err)
+	}
+
+	if expected := (&CloudSecurityGroup{ID: 1337, IsProtected: true}); !reflect.DeepEqual(securityGroup, expected) {
+		t.Errorf("CloudSecurityGroups.UpdateProtectionMode\n got=%#v\nwant=%#v", securityGroup, expected)
+	}
+}
+
+func TestCloudSecurityGroups_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/CloudSecurityGroup/"+testSecurityGroupID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.CloudSecurityGroups.Delete(ctx, testSecurityGroupID)
+	if err != nil {
+		t.Errorf("CloudSecurityGroups.Delete returned error: %v", err)
+	}
+}
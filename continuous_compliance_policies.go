@@ -0,0 +1,146 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const continuousCompliancePoliciesBasePath = "v2/Compliance/ContinuousCompliancePolicy"
+
+// ContinuousCompliancePoliciesService resource has methods to manage
+// continuous compliance policies. A policy binds a ruleset to a cloud account,
+// which is then assessed continuously, and sends the findings to
+// notifications.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-ContinuousCompliancePolicy
+type ContinuousCompliancePoliciesService interface {
+	List(context.Context) ([]ContinuousCompliancePolicy, *http.Response, error)
+	Get(context.Context, string) (*ContinuousCompliancePolicy, *http.Response, error)
+	Create(context.Context, *ContinuousCompliancePolicyRequest) (*ContinuousCompliancePolicy, *http.Response, error)
+	Update(context.Context, string, *ContinuousCompliancePolicyRequest) (*ContinuousCompliancePolicy, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// ContinuousCompliancePoliciesServiceOp handles communication with the
+// ContinuousCompliancePolicy related methods of the Dome9 API.
+type ContinuousCompliancePoliciesServiceOp struct {
+	client *Client
+}
+
+var _ ContinuousCompliancePoliciesService = &ContinuousCompliancePoliciesServiceOp{}
+
+// ContinuousCompliancePolicy binds a ruleset to a cloud account and to the
+// notifications of its findings.
+type ContinuousCompliancePolicy struct {
+	ID                string   `json:"id"`
+	CloudAccountID    string   `json:"cloudAccountId"`
+	ExternalAccountID string   `json:"externalAccountId"`
+	CloudAccountType  string   `json:"cloudAccountType"`
+	BundleID          int64    `json:"bundleId"`
+	NotificationIDs   []string `json:"notificationIds"`
+}
+
+// ContinuousCompliancePolicyRequest is used to create or update a continuous
+// compliance policy. BundleID is the ID of a Ruleset.
+type ContinuousCompliancePolicyRequest struct {
+	CloudAccountID    string   `json:"cloudAccountId"`
+	ExternalAccountID string   `json:"externalAccountId,omitempty"`
+	CloudAccountType  string   `json:"cloudAccountType"`
+	BundleID          int64    `json:"bundleId"`
+	NotificationIDs   []string `json:"notificationIds"`
+}
+
+// List all continuous compliance policies.
+func (s *ContinuousCompliancePoliciesServiceOp) List(ctx context.Context) ([]ContinuousCompliancePolicy, *http.Response, error) {
+	path := continuousCompliancePoliciesBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policies []ContinuousCompliancePolicy
+	resp, err := s.client.Do(ctx, req, &policies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policies, resp, err
+}
+
+// Get a continuous compliance policy by its ID.
+func (s *ContinuousCompliancePoliciesServiceOp) Get(ctx context.Context, policyID string) (*ContinuousCompliancePolicy, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", continuousCompliancePoliciesBasePath, policyID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(ContinuousCompliancePolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, err
+}
+
+// Create a continuous compliance policy.
+func (s *ContinuousCompliancePoliciesServiceOp) Create(ctx context.Context, createRequest *ContinuousCompliancePolicyRequest) (*ContinuousCompliancePolicy, *http.Response, error) {
+	path := continuousCompliancePoliciesBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(ContinuousCompliancePolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, err
+}
+
+// Update a continuous compliance policy.
+func (s *ContinuousCompliancePoliciesServiceOp) Update(ctx context.Context, policyID string, updateRequest *ContinuousCompliancePolicyRequest) (*ContinuousCompliancePolicy, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", continuousCompliancePoliciesBasePath, policyID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := new(ContinuousCompliancePolicy)
+	resp, err := s.client.Do(ctx, req, policy)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policy, resp, err
+}
+
+// Delete a continuous compliance policy.
+func (s *ContinuousCompliancePoliciesServiceOp) Delete(ctx context.Context, policyID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", continuousCompliancePoliciesBasePath, policyID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
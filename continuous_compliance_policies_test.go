@@ -0,0 +1,121 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testPolicyID = "1337-policy"
+
+func TestContinuousCompliancePolicies_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousCompliancePolicy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "cloudAccountId": "00000000-0000-0000-0000-000000000000",
+    "externalAccountId": "123456789012",
+    "cloudAccountType": "Aws",
+    "bundleId": 1337,
+    "notificationIds": [
+      "00000000-0000-0000-0000-000000000000"
+    ]
+  }
+]`)
+	})
+
+	policies, _, err := client.ContinuousCompliancePolicies.List(ctx)
+	if err != nil {
+		t.Errorf("ContinuousCompliancePolicies.List returned error: %v", err)
+	}
+
+	expected := []ContinuousCompliancePolicy{{ID: "00000000-0000-0000-0000-000000000000", CloudAccountID: "00000000-0000-0000-0000-000000000000", ExternalAccountID: "123456789012", CloudAccountType: "Aws", BundleID: 1337, NotificationIDs: []string{"00000000-0000-0000-0000-000000000000"}}}
+
+	if !reflect.DeepEqual(policies, expected) {
+		t.Errorf("ContinuousCompliancePolicies.List\n got=%#v\nwant=%#v", policies, expected)
+	}
+}
+
+func TestContinuousCompliancePolicies_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousCompliancePolicy/"+testPolicyID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "`+testPolicyID+`",
+  "cloudAccountId": "00000000-0000-0000-0000-000000000000",
+  "cloudAccountType": "Aws",
+  "bundleId": 1337,
+  "notificationIds": []
+}`)
+	})
+
+	policy, _, err := client.ContinuousCompliancePolicies.Get(ctx, testPolicyID)
+	if err != nil {
+		t.Errorf("ContinuousCompliancePolicies.Get returned error: %v", err)
+	}
+
+	if expected := (&ContinuousCompliancePolicy{ID: testPolicyID, CloudAccountID: "00000000-0000-0000-0000-000000000000", CloudAccountType: "Aws", BundleID: 1337, NotificationIDs: []string{}}); !reflect.DeepEqual(policy, expected) {
+		t.Errorf("ContinuousCompliancePolicies.Get\n got=%#v\nwant=%#v", policy, expected)
+	}
+}
+
+func TestContinuousCompliancePolicies_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousCompliancePolicy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"cloudAccountId":"acct","cloudAccountType":"Aws","bundleId":1337,"notificationIds":["notif"]}`)
+		fmt.Fprint(w, `{"id": "`+testPolicyID+`", "cloudAccountId": "acct", "cloudAccountType": "Aws", "bundleId": 1337, "notificationIds": ["notif"]}`)
+	})
+
+	createRequest := &ContinuousCompliancePolicyRequest{CloudAccountID: "acct", CloudAccountType: "Aws", BundleID: 1337, NotificationIDs: []string{"notif"}}
+
+	policy, _, err := client.ContinuousCompliancePolicies.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("ContinuousCompliancePolicies.Create returned error: %v", err)
+	}
+
+	if expected := (&ContinuousCompliancePolicy{ID: testPolicyID, CloudAccountID: "acct", CloudAccountType: "Aws", BundleID: 1337, NotificationIDs: []string{"notif"}}); !reflect.DeepEqual(policy, expected) {
+		t.Errorf("ContinuousCompliancePolicies.Create\n got=%#v\nwant=%#v", policy, expected)
+	}
+}
+
+func TestContinuousCompliancePolicies_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousCompliancePolicy/"+testPolicyID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{}`)
+	})
+
+	updateRequest := &ContinuousCompliancePolicyRequest{CloudAccountID: "acct", CloudAccountType: "Aws", BundleID: 1337, NotificationIDs: []string{"notif"}}
+
+	_, _, err := client.ContinuousCompliancePolicies.Update(ctx, testPolicyID, updateRequest)
+	if err != nil {
+		t.Errorf("ContinuousCompliancePolicies.Update returned error: %v", err)
+	}
+}
+
+func TestContinuousCompliancePolicies_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousCompliancePolicy/"+testPolicyID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ContinuousCompliancePolicies.Delete(ctx, testPolicyID)
+	if err != nil {
+		t.Errorf("ContinuousCompliancePolicies.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Environment variables read by EnvCredentials.
+const (
+	EnvKeyID     = "DOME9_ACCESS_ID"
+	EnvKeySecret = "DOME9_SECRET_KEY"
+)
+
+// CredentialsProvider provides the Credentials used to sign API requests.
+// It is consulted for every request, so implementations can rotate the
+// credentials, and must be safe for concurrent use.
+type CredentialsProvider interface {
+	Credentials(context.Context) (*Credentials, error)
+}
+
+// CredentialsProviderFunc is an adapter to use a function as a CredentialsProvider.
+type CredentialsProviderFunc func(context.Context) (*Credentials, error)
+
+// Credentials calls f(ctx).
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
+
+// SetCredentialsProvider is a client option for setting the provider of the
+// credentials used to sign requests. It takes precedence over the
+// Credentials given to New, which can then be nil.
+func SetCredentialsProvider(p CredentialsProvider) ClientOpt {
+	return func(c *Client) error {
+		if p == nil {
+			return fmt.Errorf("Credentials provider must not be nil")
+		}
+
+		c.credentialsProvider = p
+		return nil
+	}
+}
+
+type staticCredentials struct {
+	credentials *Credentials
+}
+
+// StaticCredentials returns a CredentialsProvider always providing a copy of
+// credentials, as they were when StaticCredentials was called. If credentials
+// is nil, the provider returns an error.
+func StaticCredentials(credentials *Credentials) CredentialsProvider {
+	p := &staticCredentials{}
+	if credentials != nil {
+		c := *credentials
+		p.credentials = &c
+	}
+	return p
+}
+
+func (p *staticCredentials) Credentials(context.Context) (*Credentials, error) {
+	if p.credentials == nil {
+		return nil, fmt.Errorf("Credentials must be provided")
+	}
+
+	c := *p.credentials
+	return &c, nil
+}
+
+// EnvCredentials returns a CredentialsProvider reading the credentials from
+// the DOME9_ACCESS_ID and DOME9_SECRET_KEY environment variables on every
+// request.
+func EnvCredentials() CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (*Credentials, error) {
+		c := &Credentials{KeyID: os.Getenv(EnvKeyID), KeySecret: os.Getenv(EnvKeySecret)}
+		if c.KeyID == "" || c.KeySecret == "" {
+			return nil, fmt.Errorf("%s and %s must be set", EnvKeyID, EnvKeySecret)
+		}
+		return c, nil
+	})
+}
+
+type fileCredentials struct {
+	path string
+
+	mu          sync.Mutex
+	modTime     time.Time
+	size        int64
+	credentials *Credentials
+}
+
+// FileCredentials returns a CredentialsProvider reading the credentials from
+// a JSON file, of the form {"keyId": "...", "keySecret": "..."}. The file is
+// read again whenever it changes, so the credentials can be rotated by
+// replacing it.
+func FileCredentials(path string) CredentialsProvider {
+	return &fileCredentials{path: path}
+}
+
+func (p *fileCredentials) Credentials(context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fi, err := os.Stat(p.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.credentials == nil || !fi.ModTime().Equal(p.modTime) || fi.Size() != p.size {
+		data, err := ioutil.ReadFile(p.path)
+		if err != nil {
+			return nil, err
+		}
+
+		var c struct {
+			KeyID     string `json:"keyId"`
+			KeySecret string `json:"keySecret"`
+		}
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("Invalid credentials file %s: %v", p.path, err)
+		}
+		if c.KeyID == "" || c.KeySecret == "" {
+			return nil, fmt.Errorf("Invalid credentials file %s: keyId and keySecret must be set", p.path)
+		}
+
+		p.credentials = &Credentials{KeyID: c.KeyID, KeySecret: c.KeySecret}
+		p.modTime = fi.ModTime()
+		p.size = fi.Size()
+	}
+
+	c := *p.credentials
+	return &c, nil
+}
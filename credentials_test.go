@@ -0,0 +1,172 @@
+package dome9
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStaticCredentials(t *testing.T) {
+	c := &Credentials{KeyID: "id", KeySecret: "secret"}
+	p := StaticCredentials(c)
+	c.KeyID = "changed"
+
+	got, err := p.Credentials(ctx)
+	if err != nil {
+		t.Fatalf("StaticCredentials returned error: %v", err)
+	}
+
+	if expected := (&Credentials{KeyID: "id", KeySecret: "secret"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("StaticCredentials\n got=%#v\nwant=%#v", got, expected)
+	}
+}
+
+func TestStaticCredentials_nil(t *testing.T) {
+	if _, err := StaticCredentials(nil).Credentials(ctx); err == nil {
+		t.Errorf("StaticCredentials(nil) expected error")
+	}
+}
+
+func TestEnvCredentials(t *testing.T) {
+	os.Setenv(EnvKeyID, "env-id")
+	os.Setenv(EnvKeySecret, "env-secret")
+	defer os.Unsetenv(EnvKeyID)
+	defer os.Unsetenv(EnvKeySecret)
+
+	got, err := EnvCredentials().Credentials(ctx)
+	if err != nil {
+		t.Fatalf("EnvCredentials returned error: %v", err)
+	}
+
+	if expected := (&Credentials{KeyID: "env-id", KeySecret: "env-secret"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("EnvCredentials\n got=%#v\nwant=%#v", got, expected)
+	}
+
+	os.Unsetenv(EnvKeySecret)
+	if _, err := EnvCredentials().Credentials(ctx); err == nil {
+		t.Errorf("EnvCredentials expected error when %s is not set", EnvKeySecret)
+	}
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dome9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "credentials.json")
+	write := func(data string, modTime time.Time) {
+		if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := FileCredentials(path)
+	if _, err := p.Credentials(ctx); err == nil {
+		t.Errorf("FileCredentials expected error when the file does not exist")
+	}
+
+	now := time.Now()
+	write(`{"keyId": "id", "keySecret": "secret"}`, now.Add(-time.Minute))
+
+	got, err := p.Credentials(ctx)
+	if err != nil {
+		t.Fatalf("FileCredentials returned error: %v", err)
+	}
+	if expected := (&Credentials{KeyID: "id", KeySecret: "secret"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("FileCredentials\n got=%#v\nwant=%#v", got, expected)
+	}
+
+	write(`{"keyId": "rotated", "keySecret": "rotated"}`, now)
+
+	got, err = p.Credentials(ctx)
+	if err != nil {
+		t.Fatalf("FileCredentials returned error: %v", err)
+	}
+	if expected := (&Credentials{KeyID: "rotated", KeySecret: "rotated"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("FileCredentials after rotation\n got=%#v\nwant=%#v", got, expected)
+	}
+
+	write(`{"keyId": ""}`, now.Add(time.Minute))
+	if _, err := p.Credentials(ctx); err == nil {
+		t.Errorf("FileCredentials expected error for incomplete credentials")
+	}
+}
+
+func TestNew_withCredentialsProvider(t *testing.T) {
+	keyID := "first"
+	var mu sync.Mutex
+	p := CredentialsProviderFunc(func(context.Context) (*Credentials, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return &Credentials{KeyID: keyID, KeySecret: "secret"}, nil
+	})
+
+	c, err := New(nil, nil, SetCredentialsProvider(p))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	req, _ := c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	if id, _, _ := req.BasicAuth(); id != "first" {
+		t.Errorf("NewRequest() KeyID = %v, expected %v", id, "first")
+	}
+
+	mu.Lock()
+	keyID = "second"
+	mu.Unlock()
+
+	req, _ = c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	if id, _, _ := req.BasicAuth(); id != "second" {
+		t.Errorf("NewRequest() KeyID = %v, expected %v", id, "second")
+	}
+}
+
+func TestNew_withoutCredentials(t *testing.T) {
+	if _, err := New(nil, nil); err == nil {
+		t.Errorf("New() expected error when neither Credentials nor a provider are set")
+	}
+	if _, err := New(nil, nil, SetCredentialsProvider(nil)); err == nil {
+		t.Errorf("New() expected error for a nil provider")
+	}
+}
+
+func TestNewRequest_credentialsProviderError(t *testing.T) {
+	providerErr := errors.New("no credentials")
+	p := CredentialsProviderFunc(func(context.Context) (*Credentials, error) {
+		return nil, providerErr
+	})
+
+	c, _ := New(nil, nil, SetCredentialsProvider(p))
+	if _, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil); err != providerErr {
+		t.Errorf("NewRequest() error = %v, expected %v", err, providerErr)
+	}
+}
+
+func TestNewRequest_nilCredentials(t *testing.T) {
+	p := CredentialsProviderFunc(func(context.Context) (*Credentials, error) {
+		return nil, nil
+	})
+
+	c, _ := New(nil, nil, SetCredentialsProvider(p))
+	if _, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil); err == nil {
+		t.Errorf("NewRequest() expected error when the provider returns no credentials")
+	}
+
+	c, _ = New(nil, creds)
+	c.Credentials = nil
+	if _, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil); err == nil {
+		t.Errorf("NewRequest() expected error when Credentials is nil")
+	}
+}
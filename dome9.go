@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
@@ -28,9 +31,13 @@ type Credentials struct {
 
 // Client for Dome9 v2 API.
 type Client struct {
-	// API Credentials.
+	// API Credentials, used when no CredentialsProvider is set. Use a
+	// CredentialsProvider to change credentials while requests are in flight.
 	Credentials *Credentials
 
+	// Provider of the API credentials, nil when Credentials are used.
+	credentialsProvider CredentialsProvider
+
 	// HTTP client.
 	client *http.Client
 
@@ -40,33 +47,81 @@ type Client struct {
 	// HTTP User agent.
 	UserAgent string
 
+	// Retry policy, nil when requests are not retried.
+	retryPolicy *RetryPolicy
+
+	// Rate limiter, nil when requests are not rate limited.
+	rateLimiter *rateLimiter
+
 	// Services used for communicating with the API
-	AzureCloudAccounts  AzureCloudAccountsService
-	Assessments         AssessmentsService
-	AssessmentHistories AssessmentHistoriesService
-	AccountTrusts       AccountTrustsService
+	AwsCloudAccounts             AwsCloudAccountsService
+	AzureCloudAccounts           AzureCloudAccountsService
+	GoogleCloudAccounts          GoogleCloudAccountsService
+	KubernetesAccounts           KubernetesAccountsService
+	Assessments                  AssessmentsService
+	AssessmentHistories          AssessmentHistoriesService
+	AccountTrusts                AccountTrustsService
+	Rulesets                     RulesetsService
+	ContinuousCompliancePolicies ContinuousCompliancePoliciesService
+	Notifications                NotificationsService
+	Findings                     FindingsService
+	Exclusions                   ExclusionsService
+	Remediations                 RemediationsService
+	Users                        UsersService
+	Roles                        RolesService
+	ServiceAccounts              ServiceAccountsService
+	IPLists                      IPListsService
+	CloudSecurityGroups          CloudSecurityGroupsService
+	AzureSecurityGroups          AzureSecurityGroupsService
+	OrganizationalUnits          OrganizationalUnitsService
+	AuditTrail                   AuditTrailService
+	AccessLeases                 AccessLeasesService
+	Assets                       AssetsService
 }
 
 // NewClient returns a new Dome9 API client.
 func NewClient(httpClient *http.Client, credentials *Credentials) (*Client, error) {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
 	if credentials == nil {
 		return nil, fmt.Errorf("Credentials must be provided")
 	}
 
+	return newClient(httpClient, credentials)
+}
+
+func newClient(httpClient *http.Client, credentials *Credentials) (*Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
 	baseURL, err := url.Parse(defaultBaseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &Client{client: httpClient, Credentials: credentials, BaseURL: baseURL, UserAgent: userAgent}
+	c.AwsCloudAccounts = &AwsCloudAccountsServiceOp{client: c}
 	c.AzureCloudAccounts = &AzureCloudAccountsServiceOp{client: c}
+	c.GoogleCloudAccounts = &GoogleCloudAccountsServiceOp{client: c}
+	c.KubernetesAccounts = &KubernetesAccountsServiceOp{client: c}
 	c.Assessments = &AssessmentsServiceOp{client: c}
 	c.AssessmentHistories = &AssessmentHistoriesServiceOp{client: c}
 	c.AccountTrusts = &AccountTrustsServiceOp{client: c}
+	c.Rulesets = &RulesetsServiceOp{client: c}
+	c.ContinuousCompliancePolicies = &ContinuousCompliancePoliciesServiceOp{client: c}
+	c.Notifications = &NotificationsServiceOp{client: c}
+	c.Findings = &FindingsServiceOp{client: c}
+	c.Exclusions = &ExclusionsServiceOp{client: c}
+	c.Remediations = &RemediationsServiceOp{client: c}
+	c.Users = &UsersServiceOp{client: c}
+	c.Roles = &RolesServiceOp{client: c}
+	c.ServiceAccounts = &ServiceAccountsServiceOp{client: c}
+	c.IPLists = &IPListsServiceOp{client: c}
+	c.CloudSecurityGroups = &CloudSecurityGroupsServiceOp{client: c}
+	c.AzureSecurityGroups = &AzureSecurityGroupsServiceOp{client: c}
+	c.OrganizationalUnits = &OrganizationalUnitsServiceOp{client: c}
+	c.AuditTrail = &AuditTrailServiceOp{client: c}
+	c.AccessLeases = &AccessLeasesServiceOp{client: c}
+	c.Assets = &AssetsServiceOp{client: c}
 
 	return c, nil
 }
@@ -74,9 +129,10 @@ func NewClient(httpClient *http.Client, credentials *Credentials) (*Client, erro
 // ClientOpt are options for New.
 type ClientOpt func(*Client) error
 
-// New returns a new Dome 9 API client instance.
+// New returns a new Dome 9 API client instance. Credentials can be nil when
+// a CredentialsProvider is set with SetCredentialsProvider.
 func New(httpClient *http.Client, credentials *Credentials, opts ...ClientOpt) (*Client, error) {
-	c, err := NewClient(httpClient, credentials)
+	c, err := newClient(httpClient, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -86,10 +142,15 @@ func New(httpClient *http.Client, credentials *Credentials, opts ...ClientOpt) (
 		}
 	}
 
+	if c.Credentials == nil && c.credentialsProvider == nil {
+		return nil, fmt.Errorf("Credentials must be provided")
+	}
+
 	return c, nil
 }
 
-// SetBaseURL is a client option for setting the base URL.
+// SetBaseURL is a client option for setting the base URL. A trailing slash is
+// added to its path when missing, so that request paths are resolved under it.
 func SetBaseURL(bu string) ClientOpt {
 	return func(c *Client) error {
 		u, err := url.Parse(bu)
@@ -97,6 +158,13 @@ func SetBaseURL(bu string) ClientOpt {
 			return err
 		}
 
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+			if u.RawPath != "" {
+				u.RawPath += "/"
+			}
+		}
+
 		c.BaseURL = u
 		return nil
 	}
@@ -134,7 +202,14 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		return nil, err
 	}
 
-	req.SetBasicAuth(c.Credentials.KeyID, c.Credentials.KeySecret)
+	credentials, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if credentials == nil {
+		return nil, fmt.Errorf("Credentials must be provided")
+	}
+	req.SetBasicAuth(credentials.KeyID, credentials.KeySecret)
 
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
@@ -143,12 +218,19 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	return req, nil
 }
 
+// credentials returns the credentials to sign a request with.
+func (c *Client) credentials(ctx context.Context) (*Credentials, error) {
+	if c.credentialsProvider != nil {
+		return c.credentialsProvider.Credentials(ctx)
+	}
+	return c.Credentials, nil
+}
+
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req = req.WithContext(ctx)
-	resp, err := c.client.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -181,11 +263,127 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 	return resp, err
 }
 
+// ErrorResponse reports an error caused by an API request.
+type ErrorResponse struct {
+	// HTTP response that caused this error.
+	Response *http.Response `json:"-"`
+
+	// HTTP status code of the response.
+	StatusCode int `json:"-"`
+
+	// HTTP method of the request.
+	Method string `json:"-"`
+
+	// URL of the request.
+	URL string `json:"-"`
+
+	// Error message returned by Dome9.
+	Message string `json:"message"`
+
+	// Error code returned by Dome9.
+	Code string `json:"code"`
+
+	// Raw response body.
+	Body []byte `json:"-"`
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Message
+	if msg == "" {
+		msg = string(bytes.TrimSpace(r.Body))
+	}
+	if r.Code != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, r.Code)
+	}
+	return fmt.Sprintf("%v %v: %d %v", r.Method, r.URL, r.StatusCode, msg)
+}
+
+// IsNotFound reports whether err is an ErrorResponse with a 404 Not Found status.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an ErrorResponse with a 409 Conflict status.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an ErrorResponse with a 401 Unauthorized status.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var errResp *ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode == code
+}
+
+// send sends req, retrying it according to the Client's retry policy and
+// waiting on the Client's rate limiter before every attempt.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			var err error
+			r, err = rewindBody(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.client.Do(r)
+		if c.rateLimiter != nil {
+			c.rateLimiter.update(resp)
+		}
+		if !c.retryPolicy.retryable(ctx, req, attempt, resp, err) {
+			return resp, err
+		}
+
+		wait := c.retryPolicy.backoff(attempt, resp)
+		if resp != nil {
+			discardBody(resp)
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
+// API error responses are returned as *ErrorResponse, with the Dome9 error message
+// decoded from the response body when present.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
 		return nil
 	}
-	return fmt.Errorf("Response status is: %v", r.StatusCode)
+
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
+	if r.Request != nil {
+		errorResponse.Method = r.Request.Method
+		if r.Request.URL != nil {
+			errorResponse.URL = r.Request.URL.String()
+		}
+	}
+
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		if err == nil && len(data) > 0 {
+			errorResponse.Body = data
+			// Dome9 does not always return JSON errors, in which case
+			// only the raw body is available.
+			_ = json.Unmarshal(data, errorResponse)
+		}
+	}
+
+	return errorResponse
 }
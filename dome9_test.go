@@ -43,6 +43,16 @@ func testMethod(t *testing.T, r *http.Request, expected string) {
 	}
 }
 
+func testBody(t *testing.T, r *http.Request, expected string) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("Error reading request body: %v", err)
+	}
+	if got := strings.TrimSpace(string(b)); got != expected {
+		t.Errorf("Request body\n got=%s\nwant=%s", got, expected)
+	}
+}
+
 type values map[string]string
 
 func testURLParseError(t *testing.T, err error) {
@@ -157,6 +167,9 @@ func TestDo_httpError(t *testing.T) {
 	if err == nil {
 		t.Error("Expected HTTP 400 error.")
 	}
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.StatusCode != 400 {
+		t.Errorf("Expected *ErrorResponse with status 400, got %#v", err)
+	}
 }
 
 // Test handling of an error caused by the internal http client's Do()
@@ -181,17 +194,35 @@ func TestDo_redirectLoop(t *testing.T) {
 }
 
 func TestCheckResponse(t *testing.T) {
+	u, _ := url.Parse("https://api.dome9.com/v2/AzureCloudAccount")
 	res := &http.Response{
-		Request:    &http.Request{},
+		Request:    &http.Request{Method: http.MethodPost, URL: u},
 		StatusCode: http.StatusBadRequest,
-		Body: ioutil.NopCloser(strings.NewReader(`{"message":"m",
-			"errors": [{"resource": "r", "field": "f", "code": "c"}]}`)),
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m","code":"c"}`)),
 	}
 	err := CheckResponse(res)
 
 	if err == nil {
 		t.Fatalf("Expected error response.")
 	}
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+
+	expected := &ErrorResponse{
+		Response:   res,
+		StatusCode: http.StatusBadRequest,
+		Method:     http.MethodPost,
+		URL:        u.String(),
+		Message:    "m",
+		Code:       "c",
+		Body:       []byte(`{"message":"m","code":"c"}`),
+	}
+	if !reflect.DeepEqual(errResp, expected) {
+		t.Errorf("CheckResponse\n got=%#v\nwant=%#v", errResp, expected)
+	}
 }
 
 // ensure that we properly handle API errors that do not contain a response
@@ -209,6 +240,59 @@ func TestCheckResponse_noBody(t *testing.T) {
 	}
 }
 
+// ensure that we keep the raw body of API errors that are not JSON
+func TestCheckResponse_nonJSONBody(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusInternalServerError,
+		Body:       ioutil.NopCloser(strings.NewReader("Internal Server Error")),
+	}
+	err := CheckResponse(res)
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Expected *ErrorResponse, got %T", err)
+	}
+	if got := string(errResp.Body); got != "Internal Server Error" {
+		t.Errorf("ErrorResponse.Body = %q, expected %q", got, "Internal Server Error")
+	}
+	if errResp.Message != "" {
+		t.Errorf("ErrorResponse.Message = %q, expected empty", errResp.Message)
+	}
+}
+
+func TestErrorResponse_helpers(t *testing.T) {
+	tests := []struct {
+		status       int
+		notFound     bool
+		conflict     bool
+		unauthorized bool
+	}{
+		{http.StatusNotFound, true, false, false},
+		{http.StatusConflict, false, true, false},
+		{http.StatusUnauthorized, false, false, true},
+		{http.StatusBadRequest, false, false, false},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", &ErrorResponse{StatusCode: tt.status})
+
+		if got := IsNotFound(err); got != tt.notFound {
+			t.Errorf("IsNotFound(%d) = %v, expected %v", tt.status, got, tt.notFound)
+		}
+		if got := IsConflict(err); got != tt.conflict {
+			t.Errorf("IsConflict(%d) = %v, expected %v", tt.status, got, tt.conflict)
+		}
+		if got := IsUnauthorized(err); got != tt.unauthorized {
+			t.Errorf("IsUnauthorized(%d) = %v, expected %v", tt.status, got, tt.unauthorized)
+		}
+	}
+
+	if IsNotFound(fmt.Errorf("not an API error")) {
+		t.Errorf("IsNotFound() expected false for non API error")
+	}
+}
+
 func TestCustomUserAgent(t *testing.T) {
 	ua := "testing/0.0.1"
 	c, err := New(nil, creds, SetUserAgent(ua))
@@ -231,10 +315,15 @@ func TestCustomBaseURL(t *testing.T) {
 		t.Fatalf("New() unexpected error: %v", err)
 	}
 
-	expected := baseURL
+	expected := baseURL + "/"
 	if got := c.BaseURL.String(); got != expected {
 		t.Errorf("New() BaseURL = %s; expected %s", got, expected)
 	}
+
+	req, _ := c.NewRequest(ctx, http.MethodGet, "v2/CloudAccounts", nil)
+	if expected := "http://localhost/foo/v2/CloudAccounts"; req.URL.String() != expected {
+		t.Errorf("NewRequest() URL = %v, expected %v", req.URL, expected)
+	}
 }
 
 func TestCustomBaseURL_badURL(t *testing.T) {
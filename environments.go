@@ -0,0 +1,61 @@
+package dome9
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// EnvEnvironment is the environment variable read by SetEnvironmentFromEnv.
+const EnvEnvironment = "DOME9_ENVIRONMENT"
+
+// environments maps the name of each Dome9 environment to the base URL of
+// its API. Clients use the "us" environment by default.
+var environments = map[string]string{
+	"us":    defaultBaseURL,
+	"eu1":   "https://api.eu1.dome9.com/",
+	"ap1":   "https://api.ap1.dome9.com/",
+	"ap2":   "https://api.ap2.dome9.com/",
+	"ap3":   "https://api.ap3.dome9.com/",
+	"cace1": "https://api.cace1.dome9.com/",
+}
+
+// SetEnvironment is a client option for setting the base URL to the one of a
+// named environment, as returned by EnvironmentNames.
+func SetEnvironment(name string) ClientOpt {
+	return func(c *Client) error {
+		bu, ok := environments[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("Unknown environment %q. Expected one of: %s", name, strings.Join(EnvironmentNames(), ", "))
+		}
+
+		return SetBaseURL(bu)(c)
+	}
+}
+
+// SetEnvironmentFromEnv is a client option for setting the environment to the
+// one named by the DOME9_ENVIRONMENT environment variable. The base URL is
+// left unchanged when the variable isn't set.
+func SetEnvironmentFromEnv() ClientOpt {
+	return func(c *Client) error {
+		name := os.Getenv(EnvEnvironment)
+		if name == "" {
+			return nil
+		}
+
+		return SetEnvironment(name)(c)
+	}
+}
+
+// EnvironmentNames returns the sorted names of the Dome9 environments
+// accepted by SetEnvironment.
+func EnvironmentNames() []string {
+	names := make([]string, 0, len(environments))
+	for name := range environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
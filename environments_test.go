@@ -0,0 +1,59 @@
+package dome9
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestSetEnvironment(t *testing.T) {
+	c, err := New(nil, creds, SetEnvironment("eu1"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if expected := "https://api.eu1.dome9.com/"; c.BaseURL.String() != expected {
+		t.Errorf("New() BaseURL = %v, expected %v", c.BaseURL, expected)
+	}
+
+	req, _ := c.NewRequest(ctx, "GET", "v2/CloudAccounts", nil)
+	if expected := "https://api.eu1.dome9.com/v2/CloudAccounts"; req.URL.String() != expected {
+		t.Errorf("NewRequest() URL = %v, expected %v", req.URL, expected)
+	}
+}
+
+func TestSetEnvironment_unknown(t *testing.T) {
+	if _, err := New(nil, creds, SetEnvironment("mars1")); err == nil {
+		t.Errorf("New() expected error for an unknown environment")
+	}
+}
+
+func TestEnvironmentNames(t *testing.T) {
+	names := EnvironmentNames()
+	names[0] = "changed"
+
+	if expected := []string{"ap1", "ap2", "ap3", "cace1", "eu1", "us"}; !reflect.DeepEqual(EnvironmentNames(), expected) {
+		t.Errorf("EnvironmentNames\n got=%#v\nwant=%#v", EnvironmentNames(), expected)
+	}
+}
+
+func TestSetEnvironmentFromEnv(t *testing.T) {
+	defer os.Unsetenv(EnvEnvironment)
+
+	c, err := New(nil, creds, SetEnvironmentFromEnv())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if c.BaseURL.String() != defaultBaseURL {
+		t.Errorf("New() BaseURL = %v, expected %v", c.BaseURL, defaultBaseURL)
+	}
+
+	os.Setenv(EnvEnvironment, "AP2")
+	c, err = New(nil, creds, SetEnvironmentFromEnv())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if expected := "https://api.ap2.dome9.com/"; c.BaseURL.String() != expected {
+		t.Errorf("New() BaseURL = %v, expected %v", c.BaseURL, expected)
+	}
+}
@@ -0,0 +1,138 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const exclusionsBasePath = "v2/Compliance/Exclusion"
+
+// ExclusionsService resource has methods to manage compliance exclusions.
+// Exclusions keep rules of a ruleset from failing for specific entities,
+// cloud accounts or regions, e.g. known false positives.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-Exclusion
+type ExclusionsService interface {
+	List(context.Context) ([]Exclusion, *http.Response, error)
+	Create(context.Context, *Exclusion) (*Exclusion, *http.Response, error)
+	Update(context.Context, string, *Exclusion) (*Exclusion, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// ExclusionsServiceOp handles communication with the Exclusions
+// related methods of the Dome9 API.
+type ExclusionsServiceOp struct {
+	client *Client
+}
+
+var _ ExclusionsService = &ExclusionsServiceOp{}
+
+// Exclusion excludes rules of a ruleset. Without Rules the whole ruleset is
+// excluded. Srls limit the exclusion to entities, or to the regions and
+// networks they identify, and CloudAccountIDs to cloud accounts.
+type Exclusion struct {
+	ID                    string              `json:"id,omitempty"`
+	RulesetID             int64               `json:"rulesetId"`
+	Rules                 []ExclusionRule     `json:"rules,omitempty"`
+	Srls                  []string            `json:"srls,omitempty"`
+	LogicExpressions      []string            `json:"logicExpressions,omitempty"`
+	CloudAccountIDs       []string            `json:"cloudAccountIds,omitempty"`
+	OrganizationalUnitIDs []string            `json:"organizationalUnitIds,omitempty"`
+	DateRange             *ExclusionDateRange `json:"dateRange,omitempty"`
+	Comment               string              `json:"comment"`
+}
+
+// ExclusionRule identifies an excluded rule by the hash of its logic.
+type ExclusionRule struct {
+	LogicHash string `json:"logicHash"`
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// NewExclusionRule returns the ExclusionRule excluding rule.
+func NewExclusionRule(rule *RuleEntity) ExclusionRule {
+	return ExclusionRule{LogicHash: rule.LogicHash, ID: rule.RuleID, Name: rule.Name}
+}
+
+// ExclusionDateRange is the period an exclusion is in effect.
+type ExclusionDateRange struct {
+	From Timestamp `json:"from"`
+	To   Timestamp `json:"to"`
+}
+
+// List all exclusions.
+func (s *ExclusionsServiceOp) List(ctx context.Context) ([]Exclusion, *http.Response, error) {
+	path := exclusionsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var exclusions []Exclusion
+	resp, err := s.client.Do(ctx, req, &exclusions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return exclusions, resp, err
+}
+
+// Create an exclusion.
+func (s *ExclusionsServiceOp) Create(ctx context.Context, exclusion *Exclusion) (*Exclusion, *http.Response, error) {
+	path := exclusionsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, exclusion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Exclusion)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update an exclusion.
+func (s *ExclusionsServiceOp) Update(ctx context.Context, exclusionID string, exclusion *Exclusion) (*Exclusion, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", exclusionsBasePath, exclusionID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, exclusion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Exclusion)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete an exclusion.
+func (s *ExclusionsServiceOp) Delete(ctx context.Context, exclusionID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", exclusionsBasePath, exclusionID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,112 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testExclusionID = "1337-exclusion"
+
+func TestExclusions_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Exclusion", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "rulesetId": 1337,
+    "rules": [
+      {
+        "logicHash": "string",
+        "id": "D9.AWS.NET.01",
+        "name": "string"
+      }
+    ],
+    "srls": ["1|123456789012|us_east_1"],
+    "cloudAccountIds": ["00000000-0000-0000-0000-000000000000"],
+    "dateRange": {
+      "from": "2018-08-26T16:11:12Z",
+      "to": "2018-09-26T16:11:12Z"
+    },
+    "comment": "string"
+  }
+]`)
+	})
+
+	exclusions, _, err := client.Exclusions.List(ctx)
+	if err != nil {
+		t.Errorf("Exclusions.List returned error: %v", err)
+	}
+
+	expected := []Exclusion{{
+		ID:              "00000000-0000-0000-0000-000000000000",
+		RulesetID:       1337,
+		Rules:           []ExclusionRule{{LogicHash: "string", ID: "D9.AWS.NET.01", Name: "string"}},
+		Srls:            []string{"1|123456789012|us_east_1"},
+		CloudAccountIDs: []string{"00000000-0000-0000-0000-000000000000"},
+		DateRange:       &ExclusionDateRange{From: testTimestamp, To: Timestamp{time.Date(2018, 9, 26, 16, 11, 12, 0, time.UTC)}},
+		Comment:         "string",
+	}}
+
+	if !reflect.DeepEqual(exclusions, expected) {
+		t.Errorf("Exclusions.List\n got=%#v\nwant=%#v", exclusions, expected)
+	}
+}
+
+func TestExclusions_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Exclusion", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"rulesetId":1337,"rules":[{"logicHash":"hash","id":"D9.AWS.NET.01","name":"string"}],"cloudAccountIds":["acct"],"comment":"false positive"}`)
+		fmt.Fprint(w, `{"id": "`+testExclusionID+`", "rulesetId": 1337, "comment": "false positive"}`)
+	})
+
+	rule := &RuleEntity{Name: "string", RuleID: "D9.AWS.NET.01", LogicHash: "hash"}
+	exclusion := &Exclusion{RulesetID: 1337, Rules: []ExclusionRule{NewExclusionRule(rule)}, CloudAccountIDs: []string{"acct"}, Comment: "false positive"}
+
+	created, _, err := client.Exclusions.Create(ctx, exclusion)
+	if err != nil {
+		t.Errorf("Exclusions.Create returned error: %v", err)
+	}
+
+	if expected := (&Exclusion{ID: testExclusionID, RulesetID: 1337, Comment: "false positive"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("Exclusions.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestExclusions_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Exclusion/"+testExclusionID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Exclusions.Update(ctx, testExclusionID, &Exclusion{RulesetID: 1337, Comment: "updated"})
+	if err != nil {
+		t.Errorf("Exclusions.Update returned error: %v", err)
+	}
+}
+
+func TestExclusions_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Exclusion/"+testExclusionID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Exclusions.Delete(ctx, testExclusionID)
+	if err != nil {
+		t.Errorf("Exclusions.Delete returned error: %v", err)
+	}
+}
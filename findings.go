@@ -0,0 +1,285 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const findingsBasePath = "v2/Compliance/Finding"
+
+// FindingsService resource has methods to search the findings of compliance
+// assessments, and to act on them.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-Finding
+type FindingsService interface {
+	Search(context.Context, *FindingSearchRequest) (*FindingSearchResponse, *http.Response, error)
+	Iterate(context.Context, *FindingSearchRequest) *FindingIterator
+	Acknowledge(context.Context, []string, string) (*http.Response, error)
+	Comment(context.Context, []string, string) (*http.Response, error)
+	Assign(context.Context, []string, string) (*http.Response, error)
+	ChangeSeverity(context.Context, []string, string, string) (*http.Response, error)
+}
+
+// FindingsServiceOp handles communication with the Findings
+// related methods of the Dome9 API.
+type FindingsServiceOp struct {
+	client *Client
+}
+
+var _ FindingsService = &FindingsServiceOp{}
+
+// Sorting directions of search results.
+const (
+	SortAscending  = 1
+	SortDescending = -1
+)
+
+// FindingSearchRequest is a findings search. Pages after the first one are
+// requested by setting SearchAfter to the value returned with the previous
+// page.
+type FindingSearchRequest struct {
+	PageSize    int                  `json:"pageSize,omitempty"`
+	Sorting     *SearchSorting       `json:"sorting,omitempty"`
+	Filter      *FindingSearchFilter `json:"filter,omitempty"`
+	SearchAfter []string             `json:"searchAfter,omitempty"`
+}
+
+// SearchSorting is the sorting of search results. Direction is SortAscending
+// or SortDescending.
+type SearchSorting struct {
+	FieldName string `json:"fieldName"`
+	Direction int    `json:"direction"`
+}
+
+// SearchFilterField filters search results on the value of a field.
+type SearchFilterField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// FindingSearchFilter filters the results of a findings search. Findings
+// match when they match any of the values given for each filter.
+type FindingSearchFilter struct {
+	CloudAccountIDs []string
+	RulesetIDs      []int64
+	Severities      []string
+	Regions         []string
+	Acknowledged    *bool
+	Excluded        *bool
+	FreeText        string
+
+	// Creation time range of the findings, ignored when both are zero.
+	// CreatedTo defaults to the current time.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+
+	// Additional filters on fields not covered above.
+	Fields []SearchFilterField
+}
+
+// MarshalJSON encodes the filter as the field list expected by the API.
+func (f FindingSearchFilter) MarshalJSON() ([]byte, error) {
+	filter := struct {
+		searchFilter
+		CreationTime *searchTimeRange `json:"creationTime,omitempty"`
+	}{
+		searchFilter: searchFilter{FreeTextPhrase: f.FreeText},
+		CreationTime: newSearchTimeRange(f.CreatedFrom, f.CreatedTo),
+	}
+
+	filter.addField("cloudAccountId", f.CloudAccountIDs...)
+	for _, id := range f.RulesetIDs {
+		filter.addField("bundleId", strconv.FormatInt(id, 10))
+	}
+	filter.addField("severity", f.Severities...)
+	filter.addField("region", f.Regions...)
+	if f.Acknowledged != nil {
+		filter.addField("acknowledged", strconv.FormatBool(*f.Acknowledged))
+	}
+	if f.Excluded != nil {
+		filter.addField("isExcluded", strconv.FormatBool(*f.Excluded))
+	}
+	filter.Fields = append(filter.Fields, f.Fields...)
+
+	return json.Marshal(filter)
+}
+
+// FindingSearchResponse is a page of findings search results.
+type FindingSearchResponse struct {
+	Findings           []Finding `json:"findings"`
+	TotalFindingsCount int64     `json:"totalFindingsCount"`
+	SearchAfter        []string  `json:"searchAfter"`
+}
+
+// Finding is a failed compliance rule for an entity.
+type Finding struct {
+	ID                     string           `json:"id"`
+	FindingKey             string           `json:"findingKey"`
+	CreatedTime            Timestamp        `json:"createdTime"`
+	UpdatedTime            Timestamp        `json:"updatedTime"`
+	LastSeenTime           Timestamp        `json:"lastSeenTime"`
+	CloudAccountType       string           `json:"cloudAccountType"`
+	CloudAccountID         string           `json:"cloudAccountId"`
+	CloudAccountExternalID string           `json:"cloudAccountExternalId"`
+	OrganizationalUnitID   string           `json:"organizationalUnitId"`
+	OrganizationalUnitPath string           `json:"organizationalUnitPath"`
+	BundleID               int64            `json:"bundleId"`
+	BundleName             string           `json:"bundleName"`
+	AlertType              string           `json:"alertType"`
+	RuleID                 string           `json:"ruleId"`
+	RuleName               string           `json:"ruleName"`
+	RuleLogic              string           `json:"ruleLogic"`
+	EntityDome9ID          string           `json:"entityDome9Id"`
+	EntityExternalID       string           `json:"entityExternalId"`
+	EntityType             string           `json:"entityType"`
+	EntityName             string           `json:"entityName"`
+	EntityNetwork          string           `json:"entityNetwork"`
+	EntityTags             []EntityTag      `json:"entityTags"`
+	Severity               string           `json:"severity"`
+	Description            string           `json:"description"`
+	Remediation            string           `json:"remediation"`
+	Tag                    string           `json:"tag"`
+	Region                 string           `json:"region"`
+	Acknowledged           bool             `json:"acknowledged"`
+	IsExcluded             bool             `json:"isExcluded"`
+	Origin                 string           `json:"origin"`
+	OwnerUserName          string           `json:"ownerUserName"`
+	Comments               []FindingComment `json:"comments"`
+	Action                 string           `json:"action"`
+}
+
+// EntityTag is a tag of a cloud entity.
+type EntityTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// FindingComment is a comment on a finding.
+type FindingComment struct {
+	Text      string    `json:"text"`
+	Timestamp Timestamp `json:"timestamp"`
+	UserName  string    `json:"userName"`
+}
+
+// findingAction is the JSON object used by the bulk finding action endpoints.
+type findingAction struct {
+	IDs      []string `json:"ids"`
+	Comment  string   `json:"comment,omitempty"`
+	Owner    string   `json:"owner,omitempty"`
+	Severity string   `json:"severity,omitempty"`
+}
+
+// Search findings. Only a page of results is returned, use Iterate to walk
+// all of them.
+func (s *FindingsServiceOp) Search(ctx context.Context, searchRequest *FindingSearchRequest) (*FindingSearchResponse, *http.Response, error) {
+	path := fmt.Sprintf("%s/search", findingsBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, searchRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FindingSearchResponse)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// Iterate returns an iterator over all the findings matching searchRequest,
+// which fetches the pages of results as needed. A nil searchRequest matches
+// all findings.
+func (s *FindingsServiceOp) Iterate(ctx context.Context, searchRequest *FindingSearchRequest) *FindingIterator {
+	if searchRequest == nil {
+		searchRequest = &FindingSearchRequest{}
+	}
+
+	request := *searchRequest
+	it := new(FindingIterator)
+	it.fetch = func() ([]interface{}, bool, error) {
+		result, _, err := s.Search(ctx, &request)
+		if err != nil {
+			return nil, false, err
+		}
+
+		request.SearchAfter = result.SearchAfter
+		page := make([]interface{}, len(result.Findings))
+		for i, finding := range result.Findings {
+			page[i] = finding
+		}
+
+		last := len(result.SearchAfter) == 0 || (request.PageSize > 0 && len(page) < request.PageSize)
+		return page, last, nil
+	}
+
+	return it
+}
+
+// Acknowledge findings, with an optional comment.
+func (s *FindingsServiceOp) Acknowledge(ctx context.Context, findingIDs []string, comment string) (*http.Response, error) {
+	return s.bulkAction(ctx, "bulkAcknowledge", findingAction{IDs: findingIDs, Comment: comment})
+}
+
+// Comment on findings.
+func (s *FindingsServiceOp) Comment(ctx context.Context, findingIDs []string, comment string) (*http.Response, error) {
+	return s.bulkAction(ctx, "bulkComment", findingAction{IDs: findingIDs, Comment: comment})
+}
+
+// Assign findings to a user.
+func (s *FindingsServiceOp) Assign(ctx context.Context, findingIDs []string, owner string) (*http.Response, error) {
+	return s.bulkAction(ctx, "bulkAssign", findingAction{IDs: findingIDs, Owner: owner})
+}
+
+// ChangeSeverity changes the severity of findings, with an optional comment.
+func (s *FindingsServiceOp) ChangeSeverity(ctx context.Context, findingIDs []string, severity, comment string) (*http.Response, error) {
+	return s.bulkAction(ctx, "bulkChangeSeverity", findingAction{IDs: findingIDs, Severity: severity, Comment: comment})
+}
+
+func (s *FindingsServiceOp) bulkAction(ctx context.Context, action string, body findingAction) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", findingsBasePath, action)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// FindingIterator iterates over the results of a findings search, fetching
+// the pages of results as needed.
+//
+//	it := client.Findings.Iterate(ctx, searchRequest)
+//	for it.Next() {
+//		finding := it.Finding()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type FindingIterator struct {
+	pager
+}
+
+// Next advances the iterator to the next finding, which is then available
+// through Finding. It returns false when there are no more findings or an
+// error occurred.
+func (it *FindingIterator) Next() bool {
+	return it.next()
+}
+
+// Finding returns the current finding.
+func (it *FindingIterator) Finding() Finding {
+	finding, _ := it.current.(Finding)
+	return finding
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *FindingIterator) Err() error {
+	return it.err
+}
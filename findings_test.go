@@ -0,0 +1,207 @@
+package dome9
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFindingSearchFilter_MarshalJSON(t *testing.T) {
+	acknowledged := false
+	filter := FindingSearchFilter{
+		CloudAccountIDs: []string{"acct"},
+		RulesetIDs:      []int64{1337},
+		Severities:      []string{"High"},
+		Regions:         []string{"us_east_1"},
+		Acknowledged:    &acknowledged,
+		FreeText:        "bucket",
+		CreatedFrom:     time.Date(2018, 8, 26, 0, 0, 0, 0, time.UTC),
+		CreatedTo:       time.Date(2018, 8, 27, 0, 0, 0, 0, time.UTC),
+		Fields:          []SearchFilterField{{Name: "entityType", Value: "Instance"}},
+	}
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	expected := `{"freeTextPhrase":"bucket","fields":[{"name":"cloudAccountId","value":"acct"},{"name":"bundleId","value":"1337"},{"name":"severity","value":"High"},{"name":"region","value":"us_east_1"},{"name":"acknowledged","value":"false"},{"name":"entityType","value":"Instance"}],"creationTime":{"from":"2018-08-26T00:00:00Z","to":"2018-08-27T00:00:00Z"}}`
+	if string(b) != expected {
+		t.Errorf("FindingSearchFilter.MarshalJSON\n got=%s\nwant=%s", b, expected)
+	}
+}
+
+func TestFindings_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Finding/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"pageSize":10,"sorting":{"fieldName":"createdTime","direction":-1},"filter":{"fields":[{"name":"severity","value":"High"}]}}`)
+		fmt.Fprint(w, `{
+  "findings": [
+    {
+      "id": "00000000-0000-0000-0000-000000000000",
+      "findingKey": "string",
+      "createdTime": "2018-08-26T16:11:12Z",
+      "updatedTime": "2018-08-26T16:11:12Z",
+      "lastSeenTime": "2018-08-26T16:11:12Z",
+      "cloudAccountType": "Aws",
+      "cloudAccountId": "00000000-0000-0000-0000-000000000000",
+      "cloudAccountExternalId": "123456789012",
+      "bundleId": 1337,
+      "bundleName": "string",
+      "ruleId": "D9.AWS.NET.01",
+      "ruleName": "string",
+      "entityDome9Id": "string",
+      "entityExternalId": "i-0123456789",
+      "entityType": "Instance",
+      "entityName": "string",
+      "entityTags": [{"key": "env", "value": "prod"}],
+      "severity": "High",
+      "region": "us_east_1",
+      "acknowledged": false,
+      "isExcluded": false,
+      "comments": [{"text": "string", "timestamp": "2018-08-26T16:11:12Z", "userName": "string"}]
+    }
+  ],
+  "totalFindingsCount": 1,
+  "searchAfter": ["a", "b"]
+}`)
+	})
+
+	searchRequest := &FindingSearchRequest{
+		PageSize: 10,
+		Sorting:  &SearchSorting{FieldName: "createdTime", Direction: SortDescending},
+		Filter:   &FindingSearchFilter{Severities: []string{"High"}},
+	}
+
+	result, _, err := client.Findings.Search(ctx, searchRequest)
+	if err != nil {
+		t.Errorf("Findings.Search returned error: %v", err)
+	}
+
+	expected := &FindingSearchResponse{
+		Findings: []Finding{{
+			ID:                     "00000000-0000-0000-0000-000000000000",
+			FindingKey:             "string",
+			CreatedTime:            testTimestamp,
+			UpdatedTime:            testTimestamp,
+			LastSeenTime:           testTimestamp,
+			CloudAccountType:       "Aws",
+			CloudAccountID:         "00000000-0000-0000-0000-000000000000",
+			CloudAccountExternalID: "123456789012",
+			BundleID:               1337,
+			BundleName:             "string",
+			RuleID:                 "D9.AWS.NET.01",
+			RuleName:               "string",
+			EntityDome9ID:          "string",
+			EntityExternalID:       "i-0123456789",
+			EntityType:             "Instance",
+			EntityName:             "string",
+			EntityTags:             []EntityTag{{Key: "env", Value: "prod"}},
+			Severity:               "High",
+			Region:                 "us_east_1",
+			Comments:               []FindingComment{{Text: "string", Timestamp: testTimestamp, UserName: "string"}},
+		}},
+		TotalFindingsCount: 1,
+		SearchAfter:        []string{"a", "b"},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Findings.Search\n got=%#v\nwant=%#v", result, expected)
+	}
+}
+
+func TestFindings_Iterate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pages := []string{
+		`{"findings": [{"id": "1"}, {"id": "2"}], "totalFindingsCount": 3, "searchAfter": ["2"]}`,
+		`{"findings": [{"id": "3"}], "totalFindingsCount": 3, "searchAfter": ["3"]}`,
+		`{"findings": [], "totalFindingsCount": 3, "searchAfter": []}`,
+	}
+	requests := 0
+	mux.HandleFunc("/v2/Compliance/Finding/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var searchRequest FindingSearchRequest
+		json.NewDecoder(r.Body).Decode(&searchRequest)
+		if requests > 0 && len(searchRequest.SearchAfter) == 0 {
+			t.Errorf("Findings.Iterate request %d without searchAfter", requests)
+		}
+		fmt.Fprint(w, pages[requests])
+		requests++
+	})
+
+	it := client.Findings.Iterate(ctx, nil)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Finding().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Findings.Iterate returned error: %v", err)
+	}
+
+	if expected := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Findings.Iterate\n got=%v\nwant=%v", ids, expected)
+	}
+	if requests != 3 {
+		t.Errorf("Findings.Iterate made %d requests, expected 3", requests)
+	}
+}
+
+func TestFindings_Iterate_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Finding/search", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	it := client.Findings.Iterate(ctx, &FindingSearchRequest{})
+	if it.Next() {
+		t.Errorf("Findings.Iterate expected no findings")
+	}
+	if it.Err() == nil {
+		t.Errorf("Findings.Iterate expected error")
+	}
+}
+
+func TestFindings_Actions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Finding/bulkAcknowledge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ids":["1","2"],"comment":"false positive"}`)
+	})
+	mux.HandleFunc("/v2/Compliance/Finding/bulkComment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ids":["1"],"comment":"looking"}`)
+	})
+	mux.HandleFunc("/v2/Compliance/Finding/bulkAssign", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ids":["1"],"owner":"user@example.com"}`)
+	})
+	mux.HandleFunc("/v2/Compliance/Finding/bulkChangeSeverity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ids":["1"],"comment":"internal only","severity":"Low"}`)
+	})
+
+	if _, err := client.Findings.Acknowledge(ctx, []string{"1", "2"}, "false positive"); err != nil {
+		t.Errorf("Findings.Acknowledge returned error: %v", err)
+	}
+	if _, err := client.Findings.Comment(ctx, []string{"1"}, "looking"); err != nil {
+		t.Errorf("Findings.Comment returned error: %v", err)
+	}
+	if _, err := client.Findings.Assign(ctx, []string{"1"}, "user@example.com"); err != nil {
+		t.Errorf("Findings.Assign returned error: %v", err)
+	}
+	if _, err := client.Findings.ChangeSeverity(ctx, []string{"1"}, "Low", "internal only"); err != nil {
+		t.Errorf("Findings.ChangeSeverity returned error: %v", err)
+	}
+}
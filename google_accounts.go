@@ -0,0 +1,264 @@
+package dome9
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const googleCloudAccountBasePath = "v2/GoogleCloudAccount"
+
+// GoogleCloudAccountsService is an interface for interfacing with the
+// GoogleCloudAccount endpoints of the Dome9 API.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-GoogleCloudAccount
+type GoogleCloudAccountsService interface {
+	List(context.Context) ([]GoogleCloudAccount, *http.Response, error)
+	Get(context.Context, string) (*GoogleCloudAccount, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+	Create(context.Context, GoogleCloudAccount) (*GoogleCloudAccount, *http.Response, error)
+	GetMissingPermissions(context.Context, string) (*CloudAccountMissingPermissions, *http.Response, error)
+	GetMissingPermissionsByEntityType(context.Context, string, string, string) ([]MissingPermission, *http.Response, error)
+	ResetMissingPermissions(context.Context, string) (*http.Response, error)
+	UpdateAccountName(context.Context, string, GoogleAccountNameMode) (*GoogleCloudAccount, *http.Response, error)
+	UpdateCredentials(context.Context, string, GoogleServiceAccountCredentials) (*GoogleCloudAccount, *http.Response, error)
+}
+
+// GoogleCloudAccountsServiceOp handles communication with the GoogleCloudAccount
+// related methods of the Dome9 API.
+type GoogleCloudAccountsServiceOp struct {
+	client *Client
+}
+
+var _ GoogleCloudAccountsService = &GoogleCloudAccountsServiceOp{}
+
+// GoogleServiceAccountCredentials is the key of the GCP service account used
+// to access a Google Cloud project, as downloaded from the GCP console.
+type GoogleServiceAccountCredentials struct {
+	Type                    string `json:"type"`
+	ProjectID               string `json:"project_id"`
+	PrivateKeyID            string `json:"private_key_id"`
+	PrivateKey              string `json:"private_key"`
+	ClientEmail             string `json:"client_email"`
+	ClientID                string `json:"client_id"`
+	AuthURI                 string `json:"auth_uri"`
+	TokenURI                string `json:"token_uri"`
+	AuthProviderX509CertURL string `json:"auth_provider_x509_cert_url"`
+	ClientX509CertURL       string `json:"client_x509_cert_url"`
+}
+
+// ParseGoogleServiceAccountKey parses the JSON key file of a GCP service account.
+func ParseGoogleServiceAccountKey(data []byte) (*GoogleServiceAccountCredentials, error) {
+	credentials := new(GoogleServiceAccountCredentials)
+	if err := json.Unmarshal(data, credentials); err != nil {
+		return nil, err
+	}
+
+	if credentials.ProjectID == "" || credentials.PrivateKey == "" || credentials.ClientEmail == "" {
+		return nil, fmt.Errorf("Service account key must have project_id, private_key and client_email")
+	}
+
+	return credentials, nil
+}
+
+// GoogleAccountGSuite are the GSuite settings of a Google Cloud account.
+type GoogleAccountGSuite struct {
+	GSuiteUser string `json:"gsuiteUser"`
+	DomainName string `json:"domainName"`
+}
+
+// GoogleCloudAccount are the details of a Google Cloud project.
+type GoogleCloudAccount struct {
+	ID                        string                           `json:"id,omitempty"`
+	Name                      string                           `json:"name"`
+	ProjectID                 string                           `json:"projectId,omitempty"`
+	CreationDate              *Timestamp                       `json:"creationDate,omitempty"`
+	ServiceAccountCredentials *GoogleServiceAccountCredentials `json:"serviceAccountCredentials,omitempty"`
+	GSuite                    *GoogleAccountGSuite             `json:"gsuite,omitempty"`
+	OrganizationalUnitID      string                           `json:"organizationalUnitId,omitempty"`
+	OrganizationalUnitPath    string                           `json:"organizationalUnitPath,omitempty"`
+	OrganizationalUnitName    string                           `json:"organizationalUnitName,omitempty"`
+	Vendor                    string                           `json:"vendor,omitempty"`
+}
+
+// GoogleAccountNameMode is used to create the JSON object to update a Google Cloud Account Name.
+type GoogleAccountNameMode struct {
+	Name string `json:"name"`
+}
+
+// googleAccountCredentialsUpdate is used to create the JSON object to update
+// the credentials of a Google Cloud Account.
+type googleAccountCredentialsUpdate struct {
+	ServiceAccountCredentials GoogleServiceAccountCredentials `json:"serviceAccountCredentials"`
+}
+
+// List all GoogleCloudAccounts.
+func (s *GoogleCloudAccountsServiceOp) List(ctx context.Context) ([]GoogleCloudAccount, *http.Response, error) {
+	path := googleCloudAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var googleAccounts []GoogleCloudAccount
+	resp, err := s.client.Do(ctx, req, &googleAccounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return googleAccounts, resp, err
+}
+
+// Get a Google Cloud account by its Dome9 ID.
+func (s *GoogleCloudAccountsServiceOp) Get(ctx context.Context, accountID string) (*GoogleCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	googleAccount := new(GoogleCloudAccount)
+	resp, err := s.client.Do(ctx, req, googleAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return googleAccount, resp, err
+}
+
+// Delete a Google Cloud account from a Dome9 account (the project is not deleted from GCP).
+func (s *GoogleCloudAccountsServiceOp) Delete(ctx context.Context, accountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
+
+// Create (onboard) a Google Cloud project to the user's Dome9 account.
+func (s *GoogleCloudAccountsServiceOp) Create(ctx context.Context, googleAccount GoogleCloudAccount) (*GoogleCloudAccount, *http.Response, error) {
+	path := googleCloudAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, googleAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(GoogleCloudAccount)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// GetMissingPermissions lists missing permissions for a Google Cloud account in a Dome9 account.
+func (s *GoogleCloudAccountsServiceOp) GetMissingPermissions(ctx context.Context, accountID string) (*CloudAccountMissingPermissions, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missingPerms := new(CloudAccountMissingPermissions)
+	resp, err := s.client.Do(ctx, req, missingPerms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return missingPerms, resp, err
+}
+
+// GetMissingPermissionsByEntityType lists missing permissions for a specific cloud entity type and Google Cloud account.
+func (s *GoogleCloudAccountsServiceOp) GetMissingPermissionsByEntityType(ctx context.Context, accountID, entityType, subType string) ([]MissingPermission, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions?entityType=%s&subType=%s", googleCloudAccountBasePath, accountID, entityType, subType)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var missingPerms []MissingPermission
+	resp, err := s.client.Do(ctx, req, &missingPerms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return missingPerms, resp, err
+}
+
+// ResetMissingPermissions resets (re-validate) the missing permissions indication for a Google Cloud account in Dome9.
+func (s *GoogleCloudAccountsServiceOp) ResetMissingPermissions(ctx context.Context, accountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s/MissingPermissions/Reset", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// ResetMissingPermissions returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+	return resp, err
+}
+
+// UpdateAccountName changes the account name (in Dome9) for a Google Cloud account.
+func (s *GoogleCloudAccountsServiceOp) UpdateAccountName(ctx context.Context, accountID string, accountName GoogleAccountNameMode) (*GoogleCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/AccountName", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, accountName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	googleAccount := new(GoogleCloudAccount)
+	resp, err := s.client.Do(ctx, req, googleAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return googleAccount, resp, err
+}
+
+// UpdateCredentials changes the service account key Dome9 uses to access a Google Cloud account.
+func (s *GoogleCloudAccountsServiceOp) UpdateCredentials(ctx context.Context, accountID string, credentials GoogleServiceAccountCredentials) (*GoogleCloudAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/Credentials", googleCloudAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, googleAccountCredentialsUpdate{ServiceAccountCredentials: credentials})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	googleAccount := new(GoogleCloudAccount)
+	resp, err := s.client.Do(ctx, req, googleAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return googleAccount, resp, err
+}
@@ -0,0 +1,156 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+const ipListsBasePath = "v2/IpList"
+
+// IPListsService resource has methods to manage IP lists, named lists of
+// CIDRs referenced from security group rules.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-IpList
+type IPListsService interface {
+	List(context.Context) ([]IPList, *http.Response, error)
+	Get(context.Context, int64) (*IPList, *http.Response, error)
+	Create(context.Context, *IPList) (*IPList, *http.Response, error)
+	Update(context.Context, int64, *IPList) (*http.Response, error)
+	Delete(context.Context, int64) (*http.Response, error)
+}
+
+// IPListsServiceOp handles communication with the IP Lists
+// related methods of the Dome9 API.
+type IPListsServiceOp struct {
+	client *Client
+}
+
+var _ IPListsService = &IPListsServiceOp{}
+
+// IPList is a named list of CIDRs.
+type IPList struct {
+	ID          int64        `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Items       []IPListItem `json:"items"`
+}
+
+// IPListItem is a CIDR in an IP list, e.g. "10.0.0.0/8".
+type IPListItem struct {
+	IP      string `json:"ip"`
+	Comment string `json:"comment"`
+}
+
+// Validate checks that the list is set and every item of it is a valid CIDR.
+func (l *IPList) Validate() error {
+	if l == nil {
+		return fmt.Errorf("IP list must be provided")
+	}
+
+	for i, item := range l.Items {
+		if _, _, err := net.ParseCIDR(item.IP); err != nil {
+			return fmt.Errorf("Invalid CIDR %q in item %d of IP list %q: %v", item.IP, i, l.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// List all IP lists.
+func (s *IPListsServiceOp) List(ctx context.Context) ([]IPList, *http.Response, error) {
+	path := ipListsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ipLists []IPList
+	resp, err := s.client.Do(ctx, req, &ipLists)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipLists, resp, err
+}
+
+// Get an IP list by its ID.
+func (s *IPListsServiceOp) Get(ctx context.Context, ipListID int64) (*IPList, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", ipListsBasePath, ipListID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipList := new(IPList)
+	resp, err := s.client.Do(ctx, req, ipList)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipList, resp, err
+}
+
+// Create an IP list. The items are validated before the request is sent.
+func (s *IPListsServiceOp) Create(ctx context.Context, ipList *IPList) (*IPList, *http.Response, error) {
+	if err := ipList.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	path := ipListsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, ipList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(IPList)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update an IP list, replacing its name, description and items. The items
+// are validated before the request is sent.
+func (s *IPListsServiceOp) Update(ctx context.Context, ipListID int64, ipList *IPList) (*http.Response, error) {
+	if err := ipList.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d", ipListsBasePath, ipListID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, ipList)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Delete an IP list.
+func (s *IPListsServiceOp) Delete(ctx context.Context, ipListID int64) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d", ipListsBasePath, ipListID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
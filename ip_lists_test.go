@@ -0,0 +1,157 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIPLists_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": 1337,
+    "name": "office",
+    "description": "string",
+    "items": [
+      {
+        "ip": "10.0.0.0/8",
+        "comment": "string"
+      }
+    ]
+  }
+]`)
+	})
+
+	ipLists, _, err := client.IPLists.List(ctx)
+	if err != nil {
+		t.Errorf("IPLists.List returned error: %v", err)
+	}
+
+	expected := []IPList{{ID: 1337, Name: "office", Description: "string", Items: []IPListItem{{IP: "10.0.0.0/8", Comment: "string"}}}}
+
+	if !reflect.DeepEqual(ipLists, expected) {
+		t.Errorf("IPLists.List\n got=%#v\nwant=%#v", ipLists, expected)
+	}
+}
+
+func TestIPLists_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": 1337,
+  "name": "office",
+  "items": [
+    {
+      "ip": "192.168.0.0/16"
+    }
+  ]
+}`)
+	})
+
+	ipList, _, err := client.IPLists.Get(ctx, 1337)
+	if err != nil {
+		t.Errorf("IPLists.Get returned error: %v", err)
+	}
+
+	if expected := (&IPList{ID: 1337, Name: "office", Items: []IPListItem{{IP: "192.168.0.0/16"}}}); !reflect.DeepEqual(ipList, expected) {
+		t.Errorf("IPLists.Get\n got=%#v\nwant=%#v", ipList, expected)
+	}
+}
+
+func TestIPLists_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"office","description":"string","items":[{"ip":"10.0.0.0/8","comment":"string"}]}`)
+		fmt.Fprint(w, `{"id": 1337, "name": "office", "description": "string", "items": [{"ip": "10.0.0.0/8", "comment": "string"}]}`)
+	})
+
+	ipList := &IPList{Name: "office", Description: "string", Items: []IPListItem{{IP: "10.0.0.0/8", Comment: "string"}}}
+
+	created, _, err := client.IPLists.Create(ctx, ipList)
+	if err != nil {
+		t.Errorf("IPLists.Create returned error: %v", err)
+	}
+
+	if expected := (&IPList{ID: 1337, Name: "office", Description: "string", Items: []IPListItem{{IP: "10.0.0.0/8", Comment: "string"}}}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("IPLists.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestIPLists_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"id":1337,"name":"office","description":"string","items":[{"ip":"10.0.0.0/8","comment":"string"}]}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ipList := &IPList{ID: 1337, Name: "office", Description: "string", Items: []IPListItem{{IP: "10.0.0.0/8", Comment: "string"}}}
+
+	_, err := client.IPLists.Update(ctx, 1337, ipList)
+	if err != nil {
+		t.Errorf("IPLists.Update returned error: %v", err)
+	}
+}
+
+func TestIPLists_invalidCIDR(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("IPLists.Create sent a request with an invalid CIDR")
+	})
+
+	ipList := &IPList{Name: "office", Items: []IPListItem{{IP: "10.0.0.0/8"}, {IP: "10.0.0.1"}}}
+
+	if _, _, err := client.IPLists.Create(ctx, ipList); err == nil {
+		t.Errorf("IPLists.Create expected error for an invalid CIDR")
+	}
+	if _, err := client.IPLists.Update(ctx, 1337, ipList); err == nil {
+		t.Errorf("IPLists.Update expected error for an invalid CIDR")
+	}
+}
+
+func TestIPLists_nil(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("IPLists.Create sent a request without an IP list")
+	})
+
+	if _, _, err := client.IPLists.Create(ctx, nil); err == nil {
+		t.Errorf("IPLists.Create expected error for a nil IP list")
+	}
+	if _, err := client.IPLists.Update(ctx, 1337, nil); err == nil {
+		t.Errorf("IPLists.Update expected error for a nil IP list")
+	}
+}
+
+func TestIPLists_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/IpList/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.IPLists.Delete(ctx, 1337)
+	if err != nil {
+		t.Errorf("IPLists.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const kubernetesAccountBasePath = "v2/KubernetesAccount"
+
+// KubernetesAccountsService is an interface for interfacing with the
+// KubernetesAccount endpoints of the Dome9 API.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-KubernetesAccount
+type KubernetesAccountsService interface {
+	List(context.Context) ([]KubernetesAccount, *http.Response, error)
+	Get(context.Context, string) (*KubernetesAccount, *http.Response, error)
+	Create(context.Context, KubernetesAccount) (*KubernetesAccount, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+	UpdateAccountName(context.Context, string, KubernetesAccountNameMode) (*KubernetesAccount, *http.Response, error)
+	UpdateOrganizationalUnit(context.Context, string, CloudAccountOrganizationalUnit) (*KubernetesAccount, *http.Response, error)
+}
+
+// KubernetesAccountsServiceOp handles communication with the KubernetesAccount
+// related methods of the Dome9 API.
+type KubernetesAccountsServiceOp struct {
+	client *Client
+}
+
+var _ KubernetesAccountsService = &KubernetesAccountsServiceOp{}
+
+// KubernetesAccount are the details of a Kubernetes cluster onboarded to Dome9.
+type KubernetesAccount struct {
+	ID                     string                   `json:"id,omitempty"`
+	Name                   string                   `json:"name"`
+	CreationDate           *Timestamp               `json:"creationDate,omitempty"`
+	Vendor                 string                   `json:"vendor,omitempty"`
+	ClusterVersion         string                   `json:"clusterVersion,omitempty"`
+	OrganizationalUnitID   string                   `json:"organizationalUnitId,omitempty"`
+	OrganizationalUnitPath string                   `json:"organizationalUnitPath,omitempty"`
+	OrganizationalUnitName string                   `json:"organizationalUnitName,omitempty"`
+	AgentStatus            *KubernetesAccountStatus `json:"agentStatus,omitempty"`
+}
+
+// KubernetesAccountStatus is the status of the Dome9 agents running on a Kubernetes cluster.
+type KubernetesAccountStatus struct {
+	Status string                  `json:"status"`
+	Agents []KubernetesAgentStatus `json:"agents"`
+}
+
+// KubernetesAgentStatus is the status of a Dome9 agent running on a Kubernetes cluster.
+type KubernetesAgentStatus struct {
+	ID         string    `json:"id"`
+	Version    string    `json:"version"`
+	Status     string    `json:"status"`
+	LastUpdate Timestamp `json:"lastUpdate"`
+}
+
+// KubernetesAccountNameMode is used to create the JSON object to update a Kubernetes Account Name.
+type KubernetesAccountNameMode struct {
+	Name string `json:"name"`
+}
+
+// List all KubernetesAccounts.
+func (s *KubernetesAccountsServiceOp) List(ctx context.Context) ([]KubernetesAccount, *http.Response, error) {
+	path := kubernetesAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var kubernetesAccounts []KubernetesAccount
+	resp, err := s.client.Do(ctx, req, &kubernetesAccounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return kubernetesAccounts, resp, err
+}
+
+// Get a Kubernetes account by its Dome9 ID.
+func (s *KubernetesAccountsServiceOp) Get(ctx context.Context, accountID string) (*KubernetesAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", kubernetesAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubernetesAccount := new(KubernetesAccount)
+	resp, err := s.client.Do(ctx, req, kubernetesAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return kubernetesAccount, resp, err
+}
+
+// Create (onboard) a Kubernetes cluster to the user's Dome9 account.
+func (s *KubernetesAccountsServiceOp) Create(ctx context.Context, kubernetesAccount KubernetesAccount) (*KubernetesAccount, *http.Response, error) {
+	path := kubernetesAccountBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, kubernetesAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(KubernetesAccount)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Delete a Kubernetes account from a Dome9 account.
+func (s *KubernetesAccountsServiceOp) Delete(ctx context.Context, accountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", kubernetesAccountBasePath, accountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
+
+// UpdateAccountName changes the account name (in Dome9) for a Kubernetes account.
+func (s *KubernetesAccountsServiceOp) UpdateAccountName(ctx context.Context, accountID string, accountName KubernetesAccountNameMode) (*KubernetesAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/AccountName", kubernetesAccountBasePath, accountID)
+
+	return s.update(ctx, path, accountName)
+}
+
+// UpdateOrganizationalUnit moves a Kubernetes account to an Organizational Unit.
+func (s *KubernetesAccountsServiceOp) UpdateOrganizationalUnit(ctx context.Context, accountID string, organizationalUnit CloudAccountOrganizationalUnit) (*KubernetesAccount, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/organizationalUnit", kubernetesAccountBasePath, accountID)
+
+	return s.update(ctx, path, organizationalUnit)
+}
+
+func (s *KubernetesAccountsServiceOp) update(ctx context.Context, path string, body interface{}) (*KubernetesAccount, *http.Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubernetesAccount := new(KubernetesAccount)
+	resp, err := s.client.Do(ctx, req, kubernetesAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return kubernetesAccount, resp, err
+}
@@ -0,0 +1,142 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestKubernetesAccounts_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "name": "string",
+    "creationDate": "2018-08-26T16:11:12Z",
+    "vendor": "kubernetes",
+    "clusterVersion": "1.12",
+    "organizationalUnitId": "00000000-0000-0000-0000-000000000000",
+    "organizationalUnitPath": "string",
+    "organizationalUnitName": "string",
+    "agentStatus": {
+      "status": "OK",
+      "agents": [
+        {
+          "id": "agent-1",
+          "version": "1.0.0",
+          "status": "OK",
+          "lastUpdate": "2018-08-26T16:11:12Z"
+        }
+      ]
+    }
+  }
+]`)
+	})
+
+	kubernetesAccounts, _, err := client.KubernetesAccounts.List(ctx)
+	if err != nil {
+		t.Errorf("KubernetesAccounts.List returned error: %v", err)
+	}
+
+	expected := []KubernetesAccount{{ID: "00000000-0000-0000-0000-000000000000", Name: "string", CreationDate: &testTimestamp, Vendor: "kubernetes", ClusterVersion: "1.12", OrganizationalUnitID: "00000000-0000-0000-0000-000000000000", OrganizationalUnitPath: "string", OrganizationalUnitName: "string", AgentStatus: &KubernetesAccountStatus{Status: "OK", Agents: []KubernetesAgentStatus{{ID: "agent-1", Version: "1.0.0", Status: "OK", LastUpdate: testTimestamp}}}}}
+
+	if !reflect.DeepEqual(kubernetesAccounts, expected) {
+		t.Errorf("KubernetesAccounts.List\n got=%#v\nwant=%#v", kubernetesAccounts, expected)
+	}
+}
+
+func TestKubernetesAccounts_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount/"+testAccountID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "`+testAccountID+`",
+  "name": "string",
+  "vendor": "kubernetes",
+  "clusterVersion": "1.12"
+}`)
+	})
+
+	kubernetesAccount, _, err := client.KubernetesAccounts.Get(ctx, testAccountID)
+	if err != nil {
+		t.Errorf("KubernetesAccounts.Get returned error: %v", err)
+	}
+
+	if expected := (&KubernetesAccount{ID: testAccountID, Name: "string", Vendor: "kubernetes", ClusterVersion: "1.12"}); !reflect.DeepEqual(kubernetesAccount, expected) {
+		t.Errorf("KubernetesAccounts.Get\n got=%#v\nwant=%#v", kubernetesAccount, expected)
+	}
+}
+
+func TestKubernetesAccounts_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"string","organizationalUnitId":"ou"}`)
+		fmt.Fprint(w, `{"id": "00000000-0000-0000-0000-000000000000", "name": "string", "organizationalUnitId": "ou"}`)
+	})
+
+	created, _, err := client.KubernetesAccounts.Create(ctx, KubernetesAccount{Name: "string", OrganizationalUnitID: "ou"})
+	if err != nil {
+		t.Errorf("KubernetesAccounts.Create returned error: %v", err)
+	}
+
+	if expected := (&KubernetesAccount{ID: "00000000-0000-0000-0000-000000000000", Name: "string", OrganizationalUnitID: "ou"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("KubernetesAccounts.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestKubernetesAccounts_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount/"+testAccountID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.KubernetesAccounts.Delete(ctx, testAccountID)
+	if err != nil {
+		t.Errorf("KubernetesAccounts.Delete returned error: %v", err)
+	}
+}
+
+func TestKubernetesAccounts_UpdateAccountName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount/"+testAccountID+"/AccountName", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"name":"foobared"}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.KubernetesAccounts.UpdateAccountName(ctx, testAccountID, KubernetesAccountNameMode{Name: "foobared"})
+	if err != nil {
+		t.Errorf("KubernetesAccounts.UpdateAccountName returned error: %v", err)
+	}
+}
+
+func TestKubernetesAccounts_UpdateOrganizationalUnit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/KubernetesAccount/"+testAccountID+"/organizationalUnit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"organizationalUnitId":"ou"}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.KubernetesAccounts.UpdateOrganizationalUnit(ctx, testAccountID, CloudAccountOrganizationalUnit{OrganizationalUnitID: "ou"})
+	if err != nil {
+		t.Errorf("KubernetesAccounts.UpdateOrganizationalUnit returned error: %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const notificationsBasePath = "v2/Compliance/ContinuousComplianceNotification"
+
+// NotificationsService resource has methods to manage the notifications used
+// by continuous compliance policies to deliver findings, by email, SNS,
+// webhooks, Slack or ticketing systems.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-ContinuousComplianceNotification
+type NotificationsService interface {
+	List(context.Context) ([]Notification, *http.Response, error)
+	Get(context.Context, string) (*Notification, *http.Response, error)
+	Create(context.Context, *Notification) (*Notification, *http.Response, error)
+	Update(context.Context, string, *Notification) (*Notification, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// NotificationsServiceOp handles communication with the
+// ContinuousComplianceNotification related methods of the Dome9 API.
+type NotificationsServiceOp struct {
+	client *Client
+}
+
+var _ NotificationsService = &NotificationsServiceOp{}
+
+// States of a notification delivery channel.
+const (
+	NotificationStateEnabled  = "Enabled"
+	NotificationStateDisabled = "Disabled"
+)
+
+// Notification is a continuous compliance notification.
+type Notification struct {
+	ID              string                       `json:"id,omitempty"`
+	Name            string                       `json:"name"`
+	Description     string                       `json:"description"`
+	AlertsConsole   bool                         `json:"alertsConsole"`
+	ScheduledReport *NotificationScheduledReport `json:"scheduledReport,omitempty"`
+	ChangeDetection *NotificationChangeDetection `json:"changeDetection,omitempty"`
+}
+
+// NotificationScheduledReport is a report of all the findings emailed on a schedule.
+type NotificationScheduledReport struct {
+	EmailSendingState string                    `json:"emailSendingState"`
+	ScheduleData      *NotificationScheduleData `json:"scheduleData,omitempty"`
+}
+
+// NotificationScheduleData is the schedule and recipients of a scheduled report.
+type NotificationScheduleData struct {
+	CronExpression string   `json:"cronExpression"`
+	Type           string   `json:"type"`
+	Recipients     []string `json:"recipients"`
+}
+
+// NotificationChangeDetection are the channels notified when findings change.
+// Each channel has a state, Enabled or Disabled, and its delivery settings.
+type NotificationChangeDetection struct {
+	EmailSendingState              string `json:"emailSendingState"`
+	EmailPerFindingSendingState    string `json:"emailPerFindingSendingState"`
+	SnsSendingState                string `json:"snsSendingState"`
+	ExternalTicketCreatingState    string `json:"externalTicketCreatingState"`
+	AwsSecurityHubIntegrationState string `json:"awsSecurityHubIntegrationState"`
+	WebhookIntegrationState        string `json:"webhookIntegrationState"`
+	SlackIntegrationState          string `json:"slackIntegrationState"`
+
+	EmailData                 *NotificationEmailData                 `json:"emailData,omitempty"`
+	EmailPerFindingData       *NotificationEmailPerFindingData       `json:"emailPerFindingData,omitempty"`
+	SnsData                   *NotificationSnsData                   `json:"snsData,omitempty"`
+	TicketingSystemData       *NotificationTicketingSystemData       `json:"ticketingSystemData,omitempty"`
+	AwsSecurityHubIntegration *NotificationAwsSecurityHubIntegration `json:"awsSecurityHubIntegration,omitempty"`
+	WebhookData               *NotificationWebhookData               `json:"webhookData,omitempty"`
+	SlackData                 *NotificationSlackData                 `json:"slackData,omitempty"`
+}
+
+// NotificationEmailData are the recipients of the email digest of changes.
+type NotificationEmailData struct {
+	Recipients []string `json:"recipients"`
+}
+
+// NotificationEmailPerFindingData are the settings of the email sent for every single finding.
+type NotificationEmailPerFindingData struct {
+	Recipients               []string `json:"recipients"`
+	NotificationOutputFormat string   `json:"notificationOutputFormat"`
+}
+
+// NotificationSnsData is the SNS topic findings are published to.
+type NotificationSnsData struct {
+	SnsTopicArn     string `json:"snsTopicArn"`
+	SnsOutputFormat string `json:"snsOutputFormat"`
+}
+
+// NotificationTicketingSystemData are the settings of the ticketing system
+// (Jira, ServiceNow, PagerDuty) tickets are opened in.
+type NotificationTicketingSystemData struct {
+	SystemType         string `json:"systemType"`
+	ShouldCloseTickets bool   `json:"shouldCloseTickets"`
+	Domain             string `json:"domain"`
+	User               string `json:"user"`
+	Pass               string `json:"pass"`
+	ProjectKey         string `json:"projectKey"`
+	IssueType          string `json:"issueType"`
+}
+
+// NotificationAwsSecurityHubIntegration is the AWS Security Hub findings are sent to.
+type NotificationAwsSecurityHubIntegration struct {
+	ExternalAccountID string `json:"externalAccountId"`
+	Region            string `json:"region"`
+}
+
+// NotificationWebhookData are the settings of the webhook findings are sent
+// to. AuthMethod can be NoAuth or BasicAuth, which uses Username and Password.
+type NotificationWebhookData struct {
+	URL        string `json:"url"`
+	HTTPMethod string `json:"httpMethod"`
+	AuthMethod string `json:"authMethod"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	FormatType string `json:"formatType"`
+}
+
+// NotificationSlackData is the Slack incoming webhook findings are sent to.
+type NotificationSlackData struct {
+	URL string `json:"url"`
+}
+
+// List all notifications.
+func (s *NotificationsServiceOp) List(ctx context.Context) ([]Notification, *http.Response, error) {
+	path := notificationsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notifications []Notification
+	resp, err := s.client.Do(ctx, req, &notifications)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notifications, resp, err
+}
+
+// Get a notification by its ID.
+func (s *NotificationsServiceOp) Get(ctx context.Context, notificationID string) (*Notification, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", notificationsBasePath, notificationID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notification := new(Notification)
+	resp, err := s.client.Do(ctx, req, notification)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return notification, resp, err
+}
+
+// Create a notification.
+func (s *NotificationsServiceOp) Create(ctx context.Context, notification *Notification) (*Notification, *http.Response, error) {
+	path := notificationsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, notification)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Notification)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update a notification.
+func (s *NotificationsServiceOp) Update(ctx context.Context, notificationID string, notification *Notification) (*Notification, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", notificationsBasePath, notificationID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, notification)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Notification)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete a notification.
+func (s *NotificationsServiceOp) Delete(ctx context.Context, notificationID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", notificationsBasePath, notificationID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,194 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testNotificationID = "1337-notification"
+
+func TestNotifications_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousComplianceNotification", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "name": "string",
+    "description": "string",
+    "alertsConsole": true,
+    "scheduledReport": {
+      "emailSendingState": "Enabled",
+      "scheduleData": {
+        "cronExpression": "0 0 10 1/1 * ? *",
+        "type": "Detailed",
+        "recipients": ["soc@example.com"]
+      }
+    },
+    "changeDetection": {
+      "emailSendingState": "Enabled",
+      "emailPerFindingSendingState": "Enabled",
+      "snsSendingState": "Disabled",
+      "externalTicketCreatingState": "Disabled",
+      "awsSecurityHubIntegrationState": "Disabled",
+      "webhookIntegrationState": "Enabled",
+      "slackIntegrationState": "Disabled",
+      "emailData": {
+        "recipients": ["soc@example.com"]
+      },
+      "emailPerFindingData": {
+        "recipients": ["oncall@example.com"],
+        "notificationOutputFormat": "JsonWithFullEntity"
+      },
+      "webhookData": {
+        "url": "https://hooks.example.com/dome9",
+        "httpMethod": "Post",
+        "authMethod": "BasicAuth",
+        "username": "user",
+        "password": "pass",
+        "formatType": "Basic"
+      }
+    }
+  }
+]`)
+	})
+
+	notifications, _, err := client.Notifications.List(ctx)
+	if err != nil {
+		t.Errorf("Notifications.List returned error: %v", err)
+	}
+
+	expected := []Notification{{
+		ID:            "00000000-0000-0000-0000-000000000000",
+		Name:          "string",
+		Description:   "string",
+		AlertsConsole: true,
+		ScheduledReport: &NotificationScheduledReport{
+			EmailSendingState: NotificationStateEnabled,
+			ScheduleData: &NotificationScheduleData{
+				CronExpression: "0 0 10 1/1 * ? *",
+				Type:           "Detailed",
+				Recipients:     []string{"soc@example.com"}}},
+		ChangeDetection: &NotificationChangeDetection{
+			EmailSendingState:              NotificationStateEnabled,
+			EmailPerFindingSendingState:    NotificationStateEnabled,
+			SnsSendingState:                NotificationStateDisabled,
+			ExternalTicketCreatingState:    NotificationStateDisabled,
+			AwsSecurityHubIntegrationState: NotificationStateDisabled,
+			WebhookIntegrationState:        NotificationStateEnabled,
+			SlackIntegrationState:          NotificationStateDisabled,
+			EmailData:                      &NotificationEmailData{Recipients: []string{"soc@example.com"}},
+			EmailPerFindingData: &NotificationEmailPerFindingData{
+				Recipients:               []string{"oncall@example.com"},
+				NotificationOutputFormat: "JsonWithFullEntity"},
+			WebhookData: &NotificationWebhookData{
+				URL:        "https://hooks.example.com/dome9",
+				HTTPMethod: "Post",
+				AuthMethod: "BasicAuth",
+				Username:   "user",
+				Password:   "pass",
+				FormatType: "Basic"}},
+	}}
+
+	if !reflect.DeepEqual(notifications, expected) {
+		t.Errorf("Notifications.List\n got=%#v\nwant=%#v", notifications, expected)
+	}
+}
+
+func TestNotifications_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousComplianceNotification/"+testNotificationID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "`+testNotificationID+`",
+  "name": "string",
+  "alertsConsole": true,
+  "changeDetection": {
+    "emailSendingState": "Enabled",
+    "emailData": {
+      "recipients": ["soc@example.com"]
+    }
+  }
+}`)
+	})
+
+	notification, _, err := client.Notifications.Get(ctx, testNotificationID)
+	if err != nil {
+		t.Errorf("Notifications.Get returned error: %v", err)
+	}
+
+	expected := &Notification{ID: testNotificationID, Name: "string", AlertsConsole: true, ChangeDetection: &NotificationChangeDetection{EmailSendingState: NotificationStateEnabled, EmailData: &NotificationEmailData{Recipients: []string{"soc@example.com"}}}}
+
+	if !reflect.DeepEqual(notification, expected) {
+		t.Errorf("Notifications.Get\n got=%#v\nwant=%#v", notification, expected)
+	}
+}
+
+func TestNotifications_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousComplianceNotification", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"string","description":"","alertsConsole":false,"changeDetection":{"emailSendingState":"Disabled","emailPerFindingSendingState":"Disabled","snsSendingState":"Disabled","externalTicketCreatingState":"Disabled","awsSecurityHubIntegrationState":"Disabled","webhookIntegrationState":"Disabled","slackIntegrationState":"Enabled","slackData":{"url":"https://hooks.slack.com/services/x"}}}`)
+		fmt.Fprint(w, `{"id": "`+testNotificationID+`", "name": "string"}`)
+	})
+
+	notification := &Notification{
+		Name: "string",
+		ChangeDetection: &NotificationChangeDetection{
+			EmailSendingState:              NotificationStateDisabled,
+			EmailPerFindingSendingState:    NotificationStateDisabled,
+			SnsSendingState:                NotificationStateDisabled,
+			ExternalTicketCreatingState:    NotificationStateDisabled,
+			AwsSecurityHubIntegrationState: NotificationStateDisabled,
+			WebhookIntegrationState:        NotificationStateDisabled,
+			SlackIntegrationState:          NotificationStateEnabled,
+			SlackData:                      &NotificationSlackData{URL: "https://hooks.slack.com/services/x"}},
+	}
+
+	created, _, err := client.Notifications.Create(ctx, notification)
+	if err != nil {
+		t.Errorf("Notifications.Create returned error: %v", err)
+	}
+
+	if expected := (&Notification{ID: testNotificationID, Name: "string"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("Notifications.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestNotifications_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousComplianceNotification/"+testNotificationID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Notifications.Update(ctx, testNotificationID, &Notification{Name: "updated"})
+	if err != nil {
+		t.Errorf("Notifications.Update returned error: %v", err)
+	}
+}
+
+func TestNotifications_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/ContinuousComplianceNotification/"+testNotificationID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Notifications.Delete(ctx, testNotificationID)
+	if err != nil {
+		t.Errorf("Notifications.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const organizationalUnitsBasePath = "v2/organizationalUnit"
+
+// OrganizationalUnitsService resource has methods to manage organizational
+// units, the hierarchy cloud accounts are placed in.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-OrganizationalUnit
+type OrganizationalUnitsService interface {
+	GetTree(context.Context) (OrganizationalUnitTree, *http.Response, error)
+	List(context.Context) ([]OrganizationalUnit, *http.Response, error)
+	Get(context.Context, string) (*OrganizationalUnit, *http.Response, error)
+	Create(context.Context, *OrganizationalUnitRequest) (*OrganizationalUnit, *http.Response, error)
+	Rename(context.Context, string, string) (*OrganizationalUnit, *http.Response, error)
+	Move(context.Context, string, string) (*OrganizationalUnit, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// OrganizationalUnitsServiceOp handles communication with the Organizational
+// Units related methods of the Dome9 API.
+type OrganizationalUnitsServiceOp struct {
+	client *Client
+}
+
+var _ OrganizationalUnitsService = &OrganizationalUnitsServiceOp{}
+
+// OrganizationalUnit is a unit of the organizational hierarchy. Path is the
+// list of IDs of the units from the root, separated by "/".
+type OrganizationalUnit struct {
+	ID                          string    `json:"id"`
+	Name                        string    `json:"name"`
+	Path                        string    `json:"path"`
+	PathStr                     string    `json:"pathStr"`
+	ParentID                    string    `json:"parentId"`
+	AccountID                   int64     `json:"accountId"`
+	Created                     Timestamp `json:"created"`
+	Updated                     Timestamp `json:"updated"`
+	AwsCloudAccountsCount       int       `json:"awsCloudAccountsCount"`
+	AzureCloudAccountsCount     int       `json:"azureCloudAccountsCount"`
+	GoogleCloudAccountsCount    int       `json:"googleCloudAccountsCount"`
+	KubernetesAccountsCount     int       `json:"k8sCloudAccountsCount"`
+	SubOrganizationalUnitsCount int       `json:"subOrganizationalUnitsCount"`
+	IsRoot                      bool      `json:"isRoot"`
+	IsParentRoot                bool      `json:"isParentRoot"`
+}
+
+// OrganizationalUnitRequest is used to create or rename an organizational
+// unit. When creating a unit, an empty ParentID creates it under the root.
+type OrganizationalUnitRequest struct {
+	Name     string `json:"name,omitempty"`
+	ParentID string `json:"parentId,omitempty"`
+}
+
+// organizationalUnitMove is the JSON object used to move an organizational
+// unit. ParentID is always sent, as an empty one moves the unit to the root.
+type organizationalUnitMove struct {
+	ParentID string `json:"parentId"`
+}
+
+// OrganizationalUnitNode is an organizational unit in an
+// OrganizationalUnitTree, linked to its parent and children.
+type OrganizationalUnitNode struct {
+	OrganizationalUnit `json:"item"`
+
+	Parent   *OrganizationalUnitNode   `json:"-"`
+	Children []*OrganizationalUnitNode `json:"children"`
+}
+
+// Find the node of an organizational unit by its ID in the subtree of n,
+// including n. It returns nil if there is no such unit.
+func (n *OrganizationalUnitNode) Find(organizationalUnitID string) *OrganizationalUnitNode {
+	var found *OrganizationalUnitNode
+	n.Walk(func(node *OrganizationalUnitNode) bool {
+		if node.ID == organizationalUnitID {
+			found = node
+			return false
+		}
+		return true
+	})
+
+	return found
+}
+
+// Walk calls fn for n and every node of its subtree, depth first, parents
+// before their children. Walking stops when fn returns false, and Walk then
+// returns false.
+func (n *OrganizationalUnitNode) Walk(fn func(*OrganizationalUnitNode) bool) bool {
+	if !fn(n) {
+		return false
+	}
+	for _, child := range n.Children {
+		if !child.Walk(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Ancestors returns the nodes from the root of the tree to the parent of n.
+func (n *OrganizationalUnitNode) Ancestors() []*OrganizationalUnitNode {
+	var ancestors []*OrganizationalUnitNode
+	for p := n.Parent; p != nil; p = p.Parent {
+		ancestors = append([]*OrganizationalUnitNode{p}, ancestors...)
+	}
+
+	return ancestors
+}
+
+func (n *OrganizationalUnitNode) link() {
+	for _, child := range n.Children {
+		child.Parent = n
+		child.link()
+	}
+}
+
+// OrganizationalUnitTree is the hierarchy of organizational units, as the
+// list of its top level nodes.
+type OrganizationalUnitTree []*OrganizationalUnitNode
+
+// Find the node of an organizational unit by its ID. It returns nil if there
+// is no such unit.
+func (t OrganizationalUnitTree) Find(organizationalUnitID string) *OrganizationalUnitNode {
+	for _, root := range t {
+		if node := root.Find(organizationalUnitID); node != nil {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Walk calls fn for every node of the tree, as OrganizationalUnitNode.Walk.
+func (t OrganizationalUnitTree) Walk(fn func(*OrganizationalUnitNode) bool) bool {
+	for _, root := range t {
+		if !root.Walk(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetTree gets the hierarchy of organizational units.
+func (s *OrganizationalUnitsServiceOp) GetTree(ctx context.Context) (OrganizationalUnitTree, *http.Response, error) {
+	path := organizationalUnitsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tree OrganizationalUnitTree
+	resp, err := s.client.Do(ctx, req, &tree)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, root := range tree {
+		root.link()
+	}
+
+	return tree, resp, err
+}
+
+// List all organizational units, without their hierarchy.
+func (s *OrganizationalUnitsServiceOp) List(ctx context.Context) ([]OrganizationalUnit, *http.Response, error) {
+	path := fmt.Sprintf("%s/GetFlatOrganizationalUnits", organizationalUnitsBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var organizationalUnits []OrganizationalUnit
+	resp, err := s.client.Do(ctx, req, &organizationalUnits)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return organizationalUnits, resp, err
+}
+
+// Get an organizational unit by its ID.
+func (s *OrganizationalUnitsServiceOp) Get(ctx context.Context, organizationalUnitID string) (*OrganizationalUnit, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", organizationalUnitsBasePath, organizationalUnitID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organizationalUnit := new(OrganizationalUnit)
+	resp, err := s.client.Do(ctx, req, organizationalUnit)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return organizationalUnit, resp, err
+}
+
+// Create an organizational unit.
+func (s *OrganizationalUnitsServiceOp) Create(ctx context.Context, createRequest *OrganizationalUnitRequest) (*OrganizationalUnit, *http.Response, error) {
+	path := organizationalUnitsBasePath
+
+	return s.send(ctx, http.MethodPost, path, createRequest)
+}
+
+// Rename an organizational unit.
+func (s *OrganizationalUnitsServiceOp) Rename(ctx context.Context, organizationalUnitID, name string) (*OrganizationalUnit, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", organizationalUnitsBasePath, organizationalUnitID)
+
+	return s.send(ctx, http.MethodPut, path, OrganizationalUnitRequest{Name: name})
+}
+
+// Move an organizational unit, with its accounts and sub units, under
+// another one. An empty parentID moves it under the root.
+func (s *OrganizationalUnitsServiceOp) Move(ctx context.Context, organizationalUnitID, parentID string) (*OrganizationalUnit, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s/move", organizationalUnitsBasePath, organizationalUnitID)
+
+	return s.send(ctx, http.MethodPut, path, organizationalUnitMove{ParentID: parentID})
+}
+
+func (s *OrganizationalUnitsServiceOp) send(ctx context.Context, method, path string, body interface{}) (*OrganizationalUnit, *http.Response, error) {
+	req, err := s.client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organizationalUnit := new(OrganizationalUnit)
+	resp, err := s.client.Do(ctx, req, organizationalUnit)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return organizationalUnit, resp, err
+}
+
+// Delete an organizational unit. It must have no accounts nor sub units.
+func (s *OrganizationalUnitsServiceOp) Delete(ctx context.Context, organizationalUnitID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", organizationalUnitsBasePath, organizationalUnitID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
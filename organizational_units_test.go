@@ -0,0 +1,229 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testOrganizationalUnitID = "00000000-0000-0000-0000-000000000001"
+
+func TestOrganizationalUnits_GetTree(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "item": {"id": "root", "name": "root", "isRoot": true},
+    "children": [
+      {
+        "item": {"id": "eng", "name": "engineering", "parentId": "root"},
+        "children": [
+          {"item": {"id": "web", "name": "web", "parentId": "eng"}, "children": []}
+        ]
+      },
+      {"item": {"id": "ops", "name": "operations", "parentId": "root"}, "children": []}
+    ]
+  }
+]`)
+	})
+
+	tree, _, err := client.OrganizationalUnits.GetTree(ctx)
+	if err != nil {
+		t.Fatalf("OrganizationalUnits.GetTree returned error: %v", err)
+	}
+
+	web := tree.Find("web")
+	if web == nil {
+		t.Fatalf("OrganizationalUnitTree.Find did not find web")
+	}
+	if web.Name != "web" || web.Parent.ID != "eng" || web.Parent.Parent.ID != "root" {
+		t.Errorf("OrganizationalUnitTree.Find returned a badly linked node: %#v", web)
+	}
+
+	var ancestors []string
+	for _, node := range web.Ancestors() {
+		ancestors = append(ancestors, node.Name)
+	}
+	if expected := []string{"root", "engineering"}; !reflect.DeepEqual(ancestors, expected) {
+		t.Errorf("OrganizationalUnitNode.Ancestors\n got=%#v\nwant=%#v", ancestors, expected)
+	}
+
+	var walked []string
+	tree.Walk(func(node *OrganizationalUnitNode) bool {
+		walked = append(walked, node.ID)
+		return true
+	})
+	if expected := []string{"root", "eng", "web", "ops"}; !reflect.DeepEqual(walked, expected) {
+		t.Errorf("OrganizationalUnitTree.Walk\n got=%#v\nwant=%#v", walked, expected)
+	}
+
+	if node := tree.Find("missing"); node != nil {
+		t.Errorf("OrganizationalUnitTree.Find returned %#v for a missing unit", node)
+	}
+}
+
+func TestOrganizationalUnits_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/GetFlatOrganizationalUnits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000001",
+    "name": "engineering",
+    "path": "00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001",
+    "pathStr": "root/engineering",
+    "parentId": "00000000-0000-0000-0000-000000000000",
+    "accountId": 1337,
+    "created": "2018-08-26T16:11:12Z",
+    "updated": "2018-08-26T16:11:12Z",
+    "awsCloudAccountsCount": 2,
+    "azureCloudAccountsCount": 1,
+    "googleCloudAccountsCount": 0,
+    "k8sCloudAccountsCount": 0,
+    "subOrganizationalUnitsCount": 1,
+    "isRoot": false,
+    "isParentRoot": true
+  }
+]`)
+	})
+
+	organizationalUnits, _, err := client.OrganizationalUnits.List(ctx)
+	if err != nil {
+		t.Errorf("OrganizationalUnits.List returned error: %v", err)
+	}
+
+	expected := []OrganizationalUnit{{
+		ID:                          testOrganizationalUnitID,
+		Name:                        "engineering",
+		Path:                        "00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001",
+		PathStr:                     "root/engineering",
+		ParentID:                    "00000000-0000-0000-0000-000000000000",
+		AccountID:                   1337,
+		Created:                     testTimestamp,
+		Updated:                     testTimestamp,
+		AwsCloudAccountsCount:       2,
+		AzureCloudAccountsCount:     1,
+		SubOrganizationalUnitsCount: 1,
+		IsParentRoot:                true,
+	}}
+
+	if !reflect.DeepEqual(organizationalUnits, expected) {
+		t.Errorf("OrganizationalUnits.List\n got=%#v\nwant=%#v", organizationalUnits, expected)
+	}
+}
+
+func TestOrganizationalUnits_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/"+testOrganizationalUnitID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": "`+testOrganizationalUnitID+`",
+  "name": "engineering",
+  "pathStr": "root/engineering",
+  "parentId": "00000000-0000-0000-0000-000000000000",
+  "awsCloudAccountsCount": 2
+}`)
+	})
+
+	organizationalUnit, _, err := client.OrganizationalUnits.Get(ctx, testOrganizationalUnitID)
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Get returned error: %v", err)
+	}
+
+	if expected := (&OrganizationalUnit{ID: testOrganizationalUnitID, Name: "engineering", PathStr: "root/engineering", ParentID: "00000000-0000-0000-0000-000000000000", AwsCloudAccountsCount: 2}); !reflect.DeepEqual(organizationalUnit, expected) {
+		t.Errorf("OrganizationalUnits.Get\n got=%#v\nwant=%#v", organizationalUnit, expected)
+	}
+}
+
+func TestOrganizationalUnits_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"engineering","parentId":"00000000-0000-0000-0000-000000000000"}`)
+		fmt.Fprint(w, `{"id": "`+testOrganizationalUnitID+`", "name": "engineering", "parentId": "00000000-0000-0000-0000-000000000000"}`)
+	})
+
+	createRequest := &OrganizationalUnitRequest{Name: "engineering", ParentID: "00000000-0000-0000-0000-000000000000"}
+
+	organizationalUnit, _, err := client.OrganizationalUnits.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Create returned error: %v", err)
+	}
+
+	if expected := (&OrganizationalUnit{ID: testOrganizationalUnitID, Name: "engineering", ParentID: "00000000-0000-0000-0000-000000000000"}); !reflect.DeepEqual(organizationalUnit, expected) {
+		t.Errorf("OrganizationalUnits.Create\n got=%#v\nwant=%#v", organizationalUnit, expected)
+	}
+}
+
+func TestOrganizationalUnits_Rename(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/"+testOrganizationalUnitID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"name":"engineering"}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.OrganizationalUnits.Rename(ctx, testOrganizationalUnitID, "engineering")
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Rename returned error: %v", err)
+	}
+}
+
+func TestOrganizationalUnits_Move(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/"+testOrganizationalUnitID+"/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"parentId":"00000000-0000-0000-0000-000000000000"}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.OrganizationalUnits.Move(ctx, testOrganizationalUnitID, "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Move returned error: %v", err)
+	}
+}
+
+func TestOrganizationalUnits_Move_root(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/"+testOrganizationalUnitID+"/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"parentId":""}`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.OrganizationalUnits.Move(ctx, testOrganizationalUnitID, "")
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Move returned error: %v", err)
+	}
+}
+
+func TestOrganizationalUnits_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/organizationalUnit/"+testOrganizationalUnitID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.OrganizationalUnits.Delete(ctx, testOrganizationalUnitID)
+	if err != nil {
+		t.Errorf("OrganizationalUnits.Delete returned error: %v", err)
+	}
+}
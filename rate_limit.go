@@ -0,0 +1,169 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// SetRateLimit is a client option for limiting the rate of requests sent to
+// the API to rps requests per second, allowing bursts of up to burst requests.
+// The limit is shared by all services of the Client. Rate limit headers from
+// API responses pause the limiter until the quota resets.
+func SetRateLimit(rps float64, burst int) ClientOpt {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return fmt.Errorf("Rate limit must be positive")
+		}
+		if burst < 1 {
+			return fmt.Errorf("Rate limit burst must be at least 1")
+		}
+
+		c.rateLimiter = newRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket rate limiter safe for concurrent use.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// Tokens added to the bucket per second.
+	rate float64
+
+	// Bucket capacity.
+	burst float64
+
+	// Tokens available at last, negative when callers are queued.
+	tokens float64
+	last   time.Time
+
+	// No request is allowed before pausedUntil.
+	pausedUntil time.Time
+
+	now func() time.Time
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, d); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.advance(now)
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if p := l.pausedUntil.Sub(now); p > wait {
+		wait = p
+	}
+	return wait
+}
+
+// cancel gives back a token taken by a caller that stopped waiting.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// advance refills the bucket with the tokens accumulated since the last call.
+func (l *rateLimiter) advance(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+}
+
+// update adapts the limiter to the rate limit information of an API response.
+func (l *rateLimiter) update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	var until time.Time
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			until = now.Add(d)
+		} else {
+			// Throttled without a hint, so drain the bucket.
+			l.advance(now)
+			if l.tokens > 0 {
+				l.tokens = 0
+			}
+		}
+	}
+
+	if resp.Header.Get(headerRateLimitRemaining) == "0" {
+		if t, ok := parseRateLimitReset(resp.Header.Get(headerRateLimitReset), now); ok && t.After(until) {
+			until = t
+		}
+	}
+
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// parseRateLimitReset parses the value of a X-RateLimit-Reset header, which
+// can be either a number of seconds until the reset or a Unix timestamp.
+func parseRateLimitReset(v string, now time.Time) (time.Time, bool) {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+
+	// Values past a day are Unix timestamps.
+	if n > 24*60*60 {
+		return time.Unix(n, 0), true
+	}
+	return now.Add(time.Duration(n) * time.Second), true
+}
@@ -0,0 +1,127 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestRateLimiter(rps float64, burst int) (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)}
+	l := newRateLimiter(rps, burst)
+	l.now = clock.now
+	l.last = clock.t
+	return l, clock
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l, clock := newTestRateLimiter(2, 2)
+
+	for i := 0; i < 2; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Errorf("reserve() #%d = %v, expected no wait within burst", i, d)
+		}
+	}
+
+	if d := l.reserve(); d != 500*time.Millisecond {
+		t.Errorf("reserve() = %v, expected %v", d, 500*time.Millisecond)
+	}
+
+	clock.t = clock.t.Add(2 * time.Second)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("reserve() after refill = %v, expected no wait", d)
+	}
+}
+
+func TestRateLimiter_updateRetryAfter(t *testing.T) {
+	l, _ := newTestRateLimiter(10, 10)
+
+	l.update(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	})
+
+	if d := l.reserve(); d != 3*time.Second {
+		t.Errorf("reserve() after 429 = %v, expected %v", d, 3*time.Second)
+	}
+}
+
+func TestRateLimiter_updateRateLimitHeaders(t *testing.T) {
+	l, clock := newTestRateLimiter(10, 10)
+
+	header := http.Header{}
+	header.Set(headerRateLimitRemaining, "0")
+	header.Set(headerRateLimitReset, strconv.FormatInt(clock.t.Add(time.Minute).Unix(), 10))
+	l.update(&http.Response{StatusCode: http.StatusOK, Header: header})
+
+	if d := l.reserve(); d != time.Minute {
+		t.Errorf("reserve() after exhausted quota = %v, expected %v", d, time.Minute)
+	}
+
+	header = http.Header{}
+	header.Set(headerRateLimitRemaining, "5")
+	header.Set(headerRateLimitReset, "1")
+	l.update(&http.Response{StatusCode: http.StatusOK, Header: header})
+
+	clock.t = clock.t.Add(time.Minute)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("reserve() after reset = %v, expected no wait", d)
+	}
+}
+
+func TestRateLimiter_waitCancelled(t *testing.T) {
+	l := newRateLimiter(0.001, 1)
+	l.reserve()
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := l.Wait(cctx); err != context.Canceled {
+		t.Errorf("Wait() = %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestDo_rateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRateLimit(50, 1)(client); err != nil {
+		t.Fatalf("SetRateLimit(): %v", err)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+		if _, err := client.Do(ctx, req, nil); err != nil {
+			t.Fatalf("Do(): %v", err)
+		}
+	}
+
+	// Two waits of 20ms, less some slack for the timer resolution.
+	expected := 35 * time.Millisecond
+	if elapsed := time.Since(start); elapsed < expected {
+		t.Errorf("3 requests at 50 rps with burst 1 took %v, expected at least %v", elapsed, expected)
+	}
+}
+
+func TestSetRateLimit_invalid(t *testing.T) {
+	if _, err := New(nil, creds, SetRateLimit(0, 1)); err == nil {
+		t.Errorf("New() expected error for zero rate")
+	}
+	if _, err := New(nil, creds, SetRateLimit(1, 0)); err == nil {
+		t.Errorf("New() expected error for zero burst")
+	}
+}
@@ -0,0 +1,120 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const remediationsBasePath = "v2/Compliance/Remediation"
+
+// RemediationsService resource has methods to manage compliance
+// remediations, which run CloudBots to fix the entities failing a rule.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-Remediation
+type RemediationsService interface {
+	List(context.Context) ([]Remediation, *http.Response, error)
+	Create(context.Context, *Remediation) (*Remediation, *http.Response, error)
+	Update(context.Context, string, *Remediation) (*Remediation, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// RemediationsServiceOp handles communication with the Remediations
+// related methods of the Dome9 API.
+type RemediationsServiceOp struct {
+	client *Client
+}
+
+var _ RemediationsService = &RemediationsServiceOp{}
+
+// Remediation runs CloudBots on the entities failing a rule of a ruleset,
+// identified by the hash of its logic. Each CloudBots entry is a bot command
+// with its arguments, e.g. "ec2_stop_instance". Without CloudAccountIDs the
+// remediation applies to all cloud accounts.
+type Remediation struct {
+	ID               string   `json:"id,omitempty"`
+	RulesetID        int64    `json:"rulesetId"`
+	LogicHash        string   `json:"logicHash"`
+	RuleName         string   `json:"ruleName,omitempty"`
+	Platform         string   `json:"platform"`
+	CloudAccountIDs  []string `json:"cloudAccountIds,omitempty"`
+	LogicExpressions []string `json:"logicExpressions,omitempty"`
+	CloudBots        []string `json:"cloudBots"`
+	Comment          string   `json:"comment"`
+}
+
+// List all remediations.
+func (s *RemediationsServiceOp) List(ctx context.Context) ([]Remediation, *http.Response, error) {
+	path := remediationsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var remediations []Remediation
+	resp, err := s.client.Do(ctx, req, &remediations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return remediations, resp, err
+}
+
+// Create a remediation.
+func (s *RemediationsServiceOp) Create(ctx context.Context, remediation *Remediation) (*Remediation, *http.Response, error) {
+	path := remediationsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, remediation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Remediation)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update a remediation.
+func (s *RemediationsServiceOp) Update(ctx context.Context, remediationID string, remediation *Remediation) (*Remediation, *http.Response, error) {
+	path := fmt.Sprintf("%s/%s", remediationsBasePath, remediationID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, remediation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Remediation)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete a remediation.
+func (s *RemediationsServiceOp) Delete(ctx context.Context, remediationID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", remediationsBasePath, remediationID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
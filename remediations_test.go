@@ -0,0 +1,103 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testRemediationID = "1337-remediation"
+
+func TestRemediations_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Remediation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": "00000000-0000-0000-0000-000000000000",
+    "rulesetId": 1337,
+    "logicHash": "string",
+    "ruleName": "string",
+    "platform": "Aws",
+    "cloudAccountIds": ["00000000-0000-0000-0000-000000000000"],
+    "cloudBots": ["ec2_stop_instance"],
+    "comment": "string"
+  }
+]`)
+	})
+
+	remediations, _, err := client.Remediations.List(ctx)
+	if err != nil {
+		t.Errorf("Remediations.List returned error: %v", err)
+	}
+
+	expected := []Remediation{{
+		ID:              "00000000-0000-0000-0000-000000000000",
+		RulesetID:       1337,
+		LogicHash:       "string",
+		RuleName:        "string",
+		Platform:        "Aws",
+		CloudAccountIDs: []string{"00000000-0000-0000-0000-000000000000"},
+		CloudBots:       []string{"ec2_stop_instance"},
+		Comment:         "string",
+	}}
+
+	if !reflect.DeepEqual(remediations, expected) {
+		t.Errorf("Remediations.List\n got=%#v\nwant=%#v", remediations, expected)
+	}
+}
+
+func TestRemediations_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Remediation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"rulesetId":1337,"logicHash":"string","platform":"Aws","cloudBots":["ec2_stop_instance"],"comment":""}`)
+		fmt.Fprint(w, `{"id": "`+testRemediationID+`", "rulesetId": 1337, "logicHash": "string", "platform": "Aws", "cloudBots": ["ec2_stop_instance"]}`)
+	})
+
+	remediation := &Remediation{RulesetID: 1337, LogicHash: "string", Platform: "Aws", CloudBots: []string{"ec2_stop_instance"}}
+
+	created, _, err := client.Remediations.Create(ctx, remediation)
+	if err != nil {
+		t.Errorf("Remediations.Create returned error: %v", err)
+	}
+
+	if expected := (&Remediation{ID: testRemediationID, RulesetID: 1337, LogicHash: "string", Platform: "Aws", CloudBots: []string{"ec2_stop_instance"}}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("Remediations.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestRemediations_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Remediation/"+testRemediationID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Remediations.Update(ctx, testRemediationID, &Remediation{RulesetID: 1337, Comment: "updated"})
+	if err != nil {
+		t.Errorf("Remediations.Update returned error: %v", err)
+	}
+}
+
+func TestRemediations_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Remediation/"+testRemediationID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Remediations.Delete(ctx, testRemediationID)
+	if err != nil {
+		t.Errorf("Remediations.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries failed requests. Requests are
+// retried on transport errors and on 429 Too Many Requests and 5xx (500, 502,
+// 503 and 504) responses. Non-idempotent requests are only retried on 429
+// and on 503 with a Retry-After header, which mean the server didn't process
+// the request, unless RetryNonIdempotent is set. Responses with a Retry-After
+// longer than MaxBackoff are returned to the caller instead of being retried
+// early.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values lower than
+	// 2 disable retries.
+	MaxAttempts int
+
+	// Backoff before the first retry. Following retries double it, up to
+	// MaxBackoff. The actual wait is jittered between zero and that value.
+	MinBackoff time.Duration
+
+	// Upper bound for the wait between attempts. A response asking, with a
+	// Retry-After header, to wait longer than that is not retried.
+	MaxBackoff time.Duration
+
+	// Retry non-idempotent methods (POST and PATCH) on transport errors and
+	// on 5xx responses too. By default they are only retried when the server
+	// didn't process them.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with sensible defaults.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// SetRetryPolicy is a client option for setting the retry policy.
+func SetRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		if p.MaxAttempts < 0 {
+			return fmt.Errorf("MaxAttempts must not be negative")
+		}
+		if p.MinBackoff <= 0 {
+			p.MinBackoff = defaultRetryMinBackoff
+		}
+		if p.MaxBackoff <= 0 {
+			p.MaxBackoff = defaultRetryMaxBackoff
+		}
+		if p.MaxBackoff < p.MinBackoff {
+			return fmt.Errorf("MaxBackoff must not be lower than MinBackoff")
+		}
+
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// retryable reports whether the request may be sent again after the given
+// attempt, which got resp or err.
+func (p *RetryPolicy) retryable(ctx context.Context, req *http.Request, attempt int, resp *http.Response, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if ctx.Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body can't be rewound.
+		return false
+	}
+	if err == nil {
+		if d, ok := retryAfter(resp); ok && d > p.MaxBackoff {
+			// Retrying before the server asked to would only fail again.
+			return false
+		}
+	}
+	if err == nil && notProcessed(resp) {
+		// Safe to retry whatever the method.
+		return true
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt, which is the
+// wait requested by resp if any.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp); ok {
+			return d
+		}
+	}
+
+	max := p.MinBackoff
+	for i := 1; i < attempt && max < p.MaxBackoff; i++ {
+		max *= 2
+	}
+	if max > p.MaxBackoff {
+		max = p.MaxBackoff
+	}
+
+	return jitter(max)
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// jitter returns a random duration in [0, max].
+func jitter(max time.Duration) time.Duration {
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+	return time.Duration(jitterRand.Int63n(int64(max) + 1))
+}
+
+// retryAfter returns the wait requested by the Retry-After header of resp.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	return parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can be
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// notProcessed reports whether resp says the server didn't process the
+// request: a 429 Too Many Requests, or a 503 Service Unavailable with a
+// Retry-After header.
+func notProcessed(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rewindBody returns a copy of req with a fresh body, so it can be sent again.
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := *req
+	r.Body = body
+	return &r, nil
+}
+
+// discardBody drains and closes the body of a response that won't be used,
+// so the underlying connection can be reused.
+func discardBody(resp *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,258 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+}
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(testRetryPolicy())(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Do() made %d attempts, expected 3", attempts)
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(testRetryPolicy())(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Do() expected 429 ErrorResponse, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Do() made %d attempts, expected 3", attempts)
+	}
+}
+
+func TestDo_retryRewindsBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p := testRetryPolicy()
+	p.RetryNonIdempotent = true
+	if err := SetRetryPolicy(p)(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/", map[string]string{"a": "b"})
+	_, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	expected := "{\"a\":\"b\"}\n"
+	if len(bodies) != 2 || bodies[0] != expected || bodies[1] != expected {
+		t.Errorf("Do() sent bodies %q, expected %q twice", bodies, expected)
+	}
+}
+
+func TestDo_retryNonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(testRetryPolicy())(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if err == nil {
+		t.Fatalf("Do() expected error")
+	}
+	if attempts != 1 {
+		t.Errorf("Do() made %d attempts for POST, expected 1", attempts)
+	}
+}
+
+func TestDo_retryNonIdempotentNotProcessed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(testRetryPolicy())(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch len(bodies) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "/", map[string]string{"a": "b"})
+	_, err := client.Do(ctx, req, nil)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	expected := "{\"a\":\"b\"}\n"
+	if len(bodies) != 3 || bodies[1] != expected || bodies[2] != expected {
+		t.Errorf("Do() sent bodies %q, expected %q three times", bodies, expected)
+	}
+}
+
+func TestDo_retryAfterTooLong(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(testRetryPolicy())(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	_, err := client.Do(ctx, req, nil)
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Do() error = %#v, expected *ErrorResponse with status 429", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Do() made %d attempts, expected 1", attempts)
+	}
+}
+
+func TestDo_retryContextCancelled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	p := testRetryPolicy()
+	p.MaxBackoff = time.Minute
+	if err := SetRetryPolicy(p)(client); err != nil {
+		t.Fatalf("SetRetryPolicy(): %v", err)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequest(cctx, http.MethodGet, "/", nil)
+	start := time.Now()
+	_, err := client.Do(cctx, req, nil)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Do() expected context.DeadlineExceeded, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Do() did not stop waiting on cancellation, took %v", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{"Sun, 26 Aug 2018 16:11:42 GMT", 30 * time.Second, true},
+		{"Sun, 26 Aug 2018 16:10:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		d, ok := parseRetryAfter(tt.value, now)
+		if d != tt.expected || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; expected %v, %v", tt.value, d, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 10, MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+
+	for attempt := 1; attempt < 10; attempt++ {
+		if d := p.backoff(attempt, nil); d < 0 || d > p.MaxBackoff {
+			t.Errorf("backoff(%d) = %v, expected within [0, %v]", attempt, d, p.MaxBackoff)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if d := p.backoff(1, resp); d != 2*time.Second {
+		t.Errorf("backoff() with Retry-After = %v, expected %v", d, 2*time.Second)
+	}
+}
+
+func TestSetRetryPolicy_invalid(t *testing.T) {
+	_, err := New(nil, creds, SetRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Second, MaxBackoff: time.Millisecond}))
+	if err == nil {
+		t.Errorf("New() expected error for MaxBackoff lower than MinBackoff")
+	}
+}
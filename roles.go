@@ -0,0 +1,147 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const rolesBasePath = "v2/Role"
+
+// RolesService resource has methods to manage roles, which grant permissions
+// to the users assigned to them.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-Role
+type RolesService interface {
+	List(context.Context) ([]Role, *http.Response, error)
+	Get(context.Context, int64) (*Role, *http.Response, error)
+	Create(context.Context, *Role) (*Role, *http.Response, error)
+	Update(context.Context, int64, *Role) (*Role, *http.Response, error)
+	Delete(context.Context, int64) (*http.Response, error)
+}
+
+// RolesServiceOp handles communication with the Roles
+// related methods of the Dome9 API.
+type RolesServiceOp struct {
+	client *Client
+}
+
+var _ RolesService = &RolesServiceOp{}
+
+// Role is a named set of permissions.
+type Role struct {
+	ID          int64        `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Permissions *Permissions `json:"permissions"`
+}
+
+// Permissions grant access to Dome9 resources. Each scope is a list of SRLs
+// of the resources it applies to, e.g. "" for all resources or
+// "1|123456789012" for an AWS account.
+type Permissions struct {
+	Access             []string `json:"access"`
+	View               []string `json:"view"`
+	Manage             []string `json:"manage"`
+	Create             []string `json:"create"`
+	Rulesets           []string `json:"rulesets,omitempty"`
+	Notifications      []string `json:"notifications,omitempty"`
+	Policies           []string `json:"policies,omitempty"`
+	AlertActions       []string `json:"alertActions,omitempty"`
+	OnBoarding         []string `json:"onBoarding,omitempty"`
+	CrossAccountAccess []string `json:"crossAccountAccess,omitempty"`
+}
+
+// List all roles.
+func (s *RolesServiceOp) List(ctx context.Context) ([]Role, *http.Response, error) {
+	path := rolesBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []Role
+	resp, err := s.client.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roles, resp, err
+}
+
+// Get a role by its ID.
+func (s *RolesServiceOp) Get(ctx context.Context, roleID int64) (*Role, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, err
+}
+
+// Create a role.
+func (s *RolesServiceOp) Create(ctx context.Context, role *Role) (*Role, *http.Response, error) {
+	path := rolesBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Role)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update a role.
+func (s *RolesServiceOp) Update(ctx context.Context, roleID int64, role *Role) (*Role, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Role)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete a role.
+func (s *RolesServiceOp) Delete(ctx context.Context, roleID int64) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,129 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRoles_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": 1337,
+    "name": "string",
+    "description": "string",
+    "permissions": {
+      "access": [""],
+      "view": ["1|123456789012"],
+      "manage": [],
+      "create": [],
+      "rulesets": ["1337"]
+    }
+  }
+]`)
+	})
+
+	roles, _, err := client.Roles.List(ctx)
+	if err != nil {
+		t.Errorf("Roles.List returned error: %v", err)
+	}
+
+	expected := []Role{{
+		ID:          1337,
+		Name:        "string",
+		Description: "string",
+		Permissions: &Permissions{
+			Access:   []string{""},
+			View:     []string{"1|123456789012"},
+			Manage:   []string{},
+			Create:   []string{},
+			Rulesets: []string{"1337"}},
+	}}
+
+	if !reflect.DeepEqual(roles, expected) {
+		t.Errorf("Roles.List\n got=%#v\nwant=%#v", roles, expected)
+	}
+}
+
+func TestRoles_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Role/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": 1337,
+  "name": "string",
+  "permissions": {
+    "view": [""]
+  }
+}`)
+	})
+
+	role, _, err := client.Roles.Get(ctx, 1337)
+	if err != nil {
+		t.Errorf("Roles.Get returned error: %v", err)
+	}
+
+	if expected := (&Role{ID: 1337, Name: "string", Permissions: &Permissions{View: []string{""}}}); !reflect.DeepEqual(role, expected) {
+		t.Errorf("Roles.Get\n got=%#v\nwant=%#v", role, expected)
+	}
+}
+
+func TestRoles_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"auditors","description":"","permissions":{"access":null,"view":[""],"manage":null,"create":null}}`)
+		fmt.Fprint(w, `{"id": 1337, "name": "auditors", "permissions": {"view": [""]}}`)
+	})
+
+	role := &Role{Name: "auditors", Permissions: &Permissions{View: []string{""}}}
+
+	created, _, err := client.Roles.Create(ctx, role)
+	if err != nil {
+		t.Errorf("Roles.Create returned error: %v", err)
+	}
+
+	if expected := (&Role{ID: 1337, Name: "auditors", Permissions: &Permissions{View: []string{""}}}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("Roles.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestRoles_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Role/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Roles.Update(ctx, 1337, &Role{Name: "auditors", Permissions: &Permissions{View: []string{""}}})
+	if err != nil {
+		t.Errorf("Roles.Update returned error: %v", err)
+	}
+}
+
+func TestRoles_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Role/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Roles.Delete(ctx, 1337)
+	if err != nil {
+		t.Errorf("Roles.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const rulesetsBasePath = "v2/Compliance/Ruleset"
+
+// RulesetsService resource has methods to manage compliance rulesets
+// (bundles). Rulesets are run on cloud accounts with AssessmentsService.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-CompliancePolicy
+type RulesetsService interface {
+	List(context.Context) ([]Ruleset, *http.Response, error)
+	Get(context.Context, int64) (*Ruleset, *http.Response, error)
+	Create(context.Context, *Ruleset) (*Ruleset, *http.Response, error)
+	Update(context.Context, *Ruleset) (*Ruleset, *http.Response, error)
+	Delete(context.Context, int64) (*http.Response, error)
+}
+
+// RulesetsServiceOp handles communication with the Rulesets
+// related methods of the Dome9 API.
+type RulesetsServiceOp struct {
+	client *Client
+}
+
+var _ RulesetsService = &RulesetsServiceOp{}
+
+// Ruleset is a compliance ruleset (bundle). Rulesets managed by Dome9 have
+// IsTemplate set and can't be changed.
+type Ruleset struct {
+	ID               int64        `json:"id,omitempty"`
+	Name             string       `json:"name"`
+	Description      string       `json:"description"`
+	CloudVendor      string       `json:"cloudVendor"`
+	Language         string       `json:"language,omitempty"`
+	Rules            []RuleEntity `json:"rules"`
+	RulesCount       int32        `json:"rulesCount,omitempty"`
+	AccountID        int64        `json:"accountId,omitempty"`
+	CreatedTime      *Timestamp   `json:"createdTime,omitempty"`
+	UpdatedTime      *Timestamp   `json:"updatedTime,omitempty"`
+	IsTemplate       bool         `json:"isTemplate"`
+	HideInCompliance bool         `json:"hideInCompliance"`
+	MinFeatureTier   string       `json:"minFeatureTier,omitempty"`
+	Section          int32        `json:"section,omitempty"`
+	TooltipText      string       `json:"tooltipText,omitempty"`
+	ShowBundle       bool         `json:"showBundle"`
+	SystemBundle     bool         `json:"systemBundle"`
+	Version          int32        `json:"version,omitempty"`
+}
+
+// List all rulesets, including the ones managed by Dome9.
+func (s *RulesetsServiceOp) List(ctx context.Context) ([]Ruleset, *http.Response, error) {
+	path := rulesetsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rulesets []Ruleset
+	resp, err := s.client.Do(ctx, req, &rulesets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rulesets, resp, err
+}
+
+// Get a ruleset by its ID.
+func (s *RulesetsServiceOp) Get(ctx context.Context, rulesetID int64) (*Ruleset, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", rulesetsBasePath, rulesetID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, err
+}
+
+// Create a ruleset.
+func (s *RulesetsServiceOp) Create(ctx context.Context, ruleset *Ruleset) (*Ruleset, *http.Response, error) {
+	path := rulesetsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, ruleset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// Update a ruleset. The ruleset is identified by its ID, and its rules are
+// replaced by the given ones.
+func (s *RulesetsServiceOp) Update(ctx context.Context, ruleset *Ruleset) (*Ruleset, *http.Response, error) {
+	path := rulesetsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, ruleset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, err
+}
+
+// Delete a ruleset.
+func (s *RulesetsServiceOp) Delete(ctx context.Context, rulesetID int64) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d", rulesetsBasePath, rulesetID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
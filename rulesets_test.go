@@ -0,0 +1,152 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRulesets_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Ruleset", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": 1337,
+    "name": "string",
+    "description": "string",
+    "cloudVendor": "aws",
+    "language": "en",
+    "rules": [
+      {
+        "name": "string",
+        "severity": "High",
+        "logic": "Instance should have vpc",
+        "description": "string",
+        "remediation": "string",
+        "complianceTag": "string",
+        "domain": "string",
+        "priority": "string",
+        "controlTitle": "string",
+        "ruleId": "string",
+        "logicHash": "string",
+        "isDefault": false
+      }
+    ],
+    "rulesCount": 1,
+    "accountId": 42,
+    "createdTime": "2018-08-26T16:11:12Z",
+    "updatedTime": "2018-08-26T16:11:12Z",
+    "isTemplate": false,
+    "hideInCompliance": false,
+    "minFeatureTier": "Trial",
+    "section": 0,
+    "tooltipText": "string",
+    "showBundle": true,
+    "systemBundle": false,
+    "version": 1
+  }
+]`)
+	})
+
+	rulesets, _, err := client.Rulesets.List(ctx)
+	if err != nil {
+		t.Errorf("Rulesets.List returned error: %v", err)
+	}
+
+	expected := []Ruleset{{ID: 1337, Name: "string", Description: "string", CloudVendor: "aws", Language: "en", Rules: []RuleEntity{{Name: "string", Severity: "High", Logic: "Instance should have vpc", Description: "string", Remediation: "string", ComplianceTag: "string", Domain: "string", Priority: "string", ControlTitle: "string", RuleID: "string", LogicHash: "string"}}, RulesCount: 1, AccountID: 42, CreatedTime: &testTimestamp, UpdatedTime: &testTimestamp, MinFeatureTier: "Trial", TooltipText: "string", ShowBundle: true, Version: 1}}
+
+	if !reflect.DeepEqual(rulesets, expected) {
+		t.Errorf("Rulesets.List\n got=%#v\nwant=%#v", rulesets, expected)
+	}
+}
+
+func TestRulesets_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Ruleset/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": 1337,
+  "name": "string",
+  "cloudVendor": "aws",
+  "rules": [
+    {
+      "name": "string",
+      "severity": "High",
+      "logic": "Instance should have vpc"
+    }
+  ],
+  "rulesCount": 1
+}`)
+	})
+
+	ruleset, _, err := client.Rulesets.Get(ctx, 1337)
+	if err != nil {
+		t.Errorf("Rulesets.Get returned error: %v", err)
+	}
+
+	if expected := (&Ruleset{ID: 1337, Name: "string", CloudVendor: "aws", Rules: []RuleEntity{{Name: "string", Severity: "High", Logic: "Instance should have vpc"}}, RulesCount: 1}); !reflect.DeepEqual(ruleset, expected) {
+		t.Errorf("Rulesets.Get\n got=%#v\nwant=%#v", ruleset, expected)
+	}
+}
+
+func TestRulesets_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Ruleset", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"string","description":"string","cloudVendor":"aws","rules":[{"name":"string","severity":"High","logic":"Instance should have vpc","description":"","remediation":"","complianceTag":"","domain":"","priority":"","controlTitle":"","ruleId":"","logicHash":"","isDefault":false}],"isTemplate":false,"hideInCompliance":false,"showBundle":false,"systemBundle":false}`)
+		fmt.Fprint(w, `{"id": 1337, "name": "string"}`)
+	})
+
+	ruleset := &Ruleset{Name: "string", Description: "string", CloudVendor: "aws", Rules: []RuleEntity{{Name: "string", Severity: "High", Logic: "Instance should have vpc"}}}
+
+	created, _, err := client.Rulesets.Create(ctx, ruleset)
+	if err != nil {
+		t.Errorf("Rulesets.Create returned error: %v", err)
+	}
+
+	if expected := (&Ruleset{ID: 1337, Name: "string"}); !reflect.DeepEqual(created, expected) {
+		t.Errorf("Rulesets.Create\n got=%#v\nwant=%#v", created, expected)
+	}
+}
+
+func TestRulesets_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Ruleset", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id": 1337, "name": "updated"}`)
+	})
+
+	updated, _, err := client.Rulesets.Update(ctx, &Ruleset{ID: 1337, Name: "updated"})
+	if err != nil {
+		t.Errorf("Rulesets.Update returned error: %v", err)
+	}
+
+	if expected := (&Ruleset{ID: 1337, Name: "updated"}); !reflect.DeepEqual(updated, expected) {
+		t.Errorf("Rulesets.Update\n got=%#v\nwant=%#v", updated, expected)
+	}
+}
+
+func TestRulesets_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/Compliance/Ruleset/1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Rulesets.Delete(ctx, 1337)
+	if err != nil {
+		t.Errorf("Rulesets.Delete returned error: %v", err)
+	}
+}
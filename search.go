@@ -0,0 +1,64 @@
+package dome9
+
+import "time"
+
+// searchFilter holds the filter fields common to the search requests.
+type searchFilter struct {
+	FreeTextPhrase string              `json:"freeTextPhrase,omitempty"`
+	Fields         []SearchFilterField `json:"fields,omitempty"`
+}
+
+// addField adds a field filter for each of values.
+func (f *searchFilter) addField(name string, values ...string) {
+	for _, value := range values {
+		f.Fields = append(f.Fields, SearchFilterField{Name: name, Value: value})
+	}
+}
+
+type searchTimeRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// newSearchTimeRange returns the time range from from to to, or nil when both
+// are zero. A zero to defaults to the current time.
+func newSearchTimeRange(from, to time.Time) *searchTimeRange {
+	if from.IsZero() && to.IsZero() {
+		return nil
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+
+	return &searchTimeRange{From: from.UTC(), To: to.UTC()}
+}
+
+// pager walks the results of a paged search. fetch requests the next page,
+// keeping track of the token of the page after it, and returns its items and
+// whether it is the last page. An empty page also ends the walk.
+type pager struct {
+	fetch func() (items []interface{}, last bool, err error)
+
+	page     []interface{}
+	current  interface{}
+	lastPage bool
+	err      error
+}
+
+// next advances the pager to the next item, fetching pages as needed. It
+// returns false when there are no more items or an error occurred.
+func (p *pager) next() bool {
+	for len(p.page) == 0 {
+		if p.lastPage || p.err != nil {
+			return false
+		}
+
+		p.page, p.lastPage, p.err = p.fetch()
+		if len(p.page) == 0 {
+			p.lastPage = true
+		}
+	}
+
+	p.current, p.page = p.page[0], p.page[1:]
+	return true
+}
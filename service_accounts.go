@@ -0,0 +1,173 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const serviceAccountsBasePath = "v2/service-account"
+
+// ServiceAccountsService resource has methods to manage service accounts,
+// which hold the API keys used by automation.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-ServiceAccount
+type ServiceAccountsService interface {
+	List(context.Context) ([]ServiceAccount, *http.Response, error)
+	Create(context.Context, *ServiceAccountRequest) (*ServiceAccountKey, *http.Response, error)
+	RegenerateKey(context.Context, string) (*ServiceAccountKey, *http.Response, error)
+	UpdateRoles(context.Context, string, []int64) (*ServiceAccount, *http.Response, error)
+	Delete(context.Context, string) (*http.Response, error)
+}
+
+// ServiceAccountsServiceOp handles communication with the ServiceAccounts
+// related methods of the Dome9 API.
+type ServiceAccountsServiceOp struct {
+	client *Client
+}
+
+var _ ServiceAccountsService = &ServiceAccountsServiceOp{}
+
+// ServiceAccount is a Dome9 service account.
+type ServiceAccount struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	APIKeyID    string    `json:"apiKeyId"`
+	RoleIDs     []int64   `json:"roleIds"`
+	DateCreated Timestamp `json:"dateCreated"`
+	LastUsed    Timestamp `json:"lastUsed"`
+}
+
+// ServiceAccountRequest is used to create a service account.
+type ServiceAccountRequest struct {
+	Name    string  `json:"name"`
+	RoleIDs []int64 `json:"roleIds"`
+}
+
+// ServiceAccountKey is the API key of a service account. The secret is only
+// returned when the key is created, and can't be retrieved afterwards.
+type ServiceAccountKey struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	APIKeyID     string  `json:"apiKeyId"`
+	APIKeySecret string  `json:"apiKeySecret"`
+	RoleIDs      []int64 `json:"roleIds"`
+}
+
+// Credentials returns the Credentials for the key, to be used with NewClient.
+func (k *ServiceAccountKey) Credentials() *Credentials {
+	return &Credentials{KeyID: k.APIKeyID, KeySecret: k.APIKeySecret}
+}
+
+// serviceAccountKeyRequest is the JSON object used to regenerate the key of a
+// service account.
+type serviceAccountKeyRequest struct {
+	ID string `json:"id"`
+}
+
+// serviceAccountRoles is the JSON object used to replace the roles of a
+// service account. RoleIDs is always sent, so that all roles can be removed.
+type serviceAccountRoles struct {
+	ID      string  `json:"id"`
+	RoleIDs []int64 `json:"roleIds"`
+}
+
+// List all service accounts.
+func (s *ServiceAccountsServiceOp) List(ctx context.Context) ([]ServiceAccount, *http.Response, error) {
+	path := serviceAccountsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var serviceAccounts []ServiceAccount
+	resp, err := s.client.Do(ctx, req, &serviceAccounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return serviceAccounts, resp, err
+}
+
+// Create a service account, returning its API key.
+func (s *ServiceAccountsServiceOp) Create(ctx context.Context, createRequest *ServiceAccountRequest) (*ServiceAccountKey, *http.Response, error) {
+	path := serviceAccountsBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(ServiceAccountKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, err
+}
+
+// RegenerateKey replaces the API key of a service account, returning the new
+// one. The previous key stops working.
+func (s *ServiceAccountsServiceOp) RegenerateKey(ctx context.Context, serviceAccountID string) (*ServiceAccountKey, *http.Response, error) {
+	path := fmt.Sprintf("%s/generate-key", serviceAccountsBasePath)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, serviceAccountKeyRequest{ID: serviceAccountID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(ServiceAccountKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, err
+}
+
+// UpdateRoles replaces the roles of a service account. An empty roleIDs
+// removes all its roles.
+func (s *ServiceAccountsServiceOp) UpdateRoles(ctx context.Context, serviceAccountID string, roleIDs []int64) (*ServiceAccount, *http.Response, error) {
+	path := serviceAccountsBasePath
+
+	if roleIDs == nil {
+		roleIDs = []int64{}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, serviceAccountRoles{ID: serviceAccountID, RoleIDs: roleIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceAccount := new(ServiceAccount)
+	resp, err := s.client.Do(ctx, req, serviceAccount)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return serviceAccount, resp, err
+}
+
+// Delete a service account.
+func (s *ServiceAccountsServiceOp) Delete(ctx context.Context, serviceAccountID string) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%s", serviceAccountsBasePath, serviceAccountID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
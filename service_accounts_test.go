@@ -0,0 +1,153 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testServiceAccountID = "1337-service-account"
+
+func TestServiceAccounts_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{
+  "id": "00000000-0000-0000-0000-000000000000",
+  "name": "string",
+  "apiKeyId": "key-id",
+  "roleIds": [1337],
+  "dateCreated": "2018-08-26T16:11:12Z",
+  "lastUsed": "2018-08-26T16:11:12Z"
+}]`)
+	})
+
+	serviceAccounts, _, err := client.ServiceAccounts.List(ctx)
+	if err != nil {
+		t.Errorf("ServiceAccounts.List returned error: %v", err)
+	}
+
+	expected := []ServiceAccount{{ID: "00000000-0000-0000-0000-000000000000", Name: "string", APIKeyID: "key-id", RoleIDs: []int64{1337}, DateCreated: testTimestamp, LastUsed: testTimestamp}}
+
+	if !reflect.DeepEqual(serviceAccounts, expected) {
+		t.Errorf("ServiceAccounts.List\n got=%#v\nwant=%#v", serviceAccounts, expected)
+	}
+}
+
+func TestServiceAccounts_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"string","roleIds":[1337]}`)
+		fmt.Fprint(w, `{
+  "id": "00000000-0000-0000-0000-000000000000",
+  "name": "string",
+  "apiKeyId": "key-id",
+  "apiKeySecret": "key-secret",
+  "roleIds": [1337]
+}`)
+	})
+
+	key, _, err := client.ServiceAccounts.Create(ctx, &ServiceAccountRequest{Name: "string", RoleIDs: []int64{1337}})
+	if err != nil {
+		t.Errorf("ServiceAccounts.Create returned error: %v", err)
+	}
+
+	expected := &ServiceAccountKey{
+		ID:           "00000000-0000-0000-0000-000000000000",
+		Name:         "string",
+		APIKeyID:     "key-id",
+		APIKeySecret: "key-secret",
+		RoleIDs:      []int64{1337},
+	}
+
+	if !reflect.DeepEqual(key, expected) {
+		t.Errorf("ServiceAccounts.Create\n got=%#v\nwant=%#v", key, expected)
+	}
+
+	expectedCreds := &Credentials{KeyID: "key-id", KeySecret: "key-secret"}
+	if got := key.Credentials(); !reflect.DeepEqual(got, expectedCreds) {
+		t.Errorf("ServiceAccountKey.Credentials\n got=%#v\nwant=%#v", got, expectedCreds)
+	}
+	if _, err := NewClient(nil, key.Credentials()); err != nil {
+		t.Errorf("NewClient with ServiceAccountKey.Credentials returned error: %v", err)
+	}
+}
+
+func TestServiceAccounts_RegenerateKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account/generate-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"id":"`+testServiceAccountID+`"}`)
+		fmt.Fprint(w, `{"id": "`+testServiceAccountID+`", "apiKeyId": "new-key-id", "apiKeySecret": "new-key-secret"}`)
+	})
+
+	key, _, err := client.ServiceAccounts.RegenerateKey(ctx, testServiceAccountID)
+	if err != nil {
+		t.Errorf("ServiceAccounts.RegenerateKey returned error: %v", err)
+	}
+
+	if expected := (&ServiceAccountKey{ID: testServiceAccountID, APIKeyID: "new-key-id", APIKeySecret: "new-key-secret"}); !reflect.DeepEqual(key, expected) {
+		t.Errorf("ServiceAccounts.RegenerateKey\n got=%#v\nwant=%#v", key, expected)
+	}
+}
+
+func TestServiceAccounts_UpdateRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"id":"`+testServiceAccountID+`","roleIds":[1,2]}`)
+		fmt.Fprint(w, `{"id": "`+testServiceAccountID+`", "roleIds": [1, 2]}`)
+	})
+
+	serviceAccount, _, err := client.ServiceAccounts.UpdateRoles(ctx, testServiceAccountID, []int64{1, 2})
+	if err != nil {
+		t.Errorf("ServiceAccounts.UpdateRoles returned error: %v", err)
+	}
+
+	if expected := &(ServiceAccount{ID: testServiceAccountID, RoleIDs: []int64{1, 2}}); !reflect.DeepEqual(serviceAccount, expected) {
+		t.Errorf("ServiceAccounts.UpdateRoles\n got=%#v\nwant=%#v", serviceAccount, expected)
+	}
+}
+
+func TestServiceAccounts_UpdateRoles_none(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"id":"`+testServiceAccountID+`","roleIds":[]}`)
+		fmt.Fprint(w, `{"id": "`+testServiceAccountID+`", "roleIds": []}`)
+	})
+
+	for _, roleIDs := range [][]int64{{}, nil} {
+		_, _, err := client.ServiceAccounts.UpdateRoles(ctx, testServiceAccountID, roleIDs)
+		if err != nil {
+			t.Errorf("ServiceAccounts.UpdateRoles returned error: %v", err)
+		}
+	}
+}
+
+func TestServiceAccounts_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/service-account/"+testServiceAccountID, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ServiceAccounts.Delete(ctx, testServiceAccountID)
+	if err != nil {
+		t.Errorf("ServiceAccounts.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package dome9
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Layouts of the timestamps returned by the API. Timestamps without a time
+// zone are in UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Timestamp is a time returned by the API. It is decoded from the ISO 8601
+// variants used by the API, with or without time zone and fractional
+// seconds, and encoded as RFC 3339. The zero Timestamp is encoded as null.
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp parses a timestamp in any of the formats used by the API.
+func ParseTimestamp(s string) (Timestamp, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return Timestamp{t}, nil
+		}
+	}
+
+	return Timestamp{}, fmt.Errorf("Invalid timestamp %q", s)
+}
+
+// MarshalJSON encodes the timestamp as RFC 3339, or null when it's zero.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON decodes the timestamp from any of the formats used by the
+// API. Null and empty strings are decoded as the zero Timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	parsed, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
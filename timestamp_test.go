@@ -0,0 +1,65 @@
+package dome9
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var testTimestamp = Timestamp{time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)}
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected time.Time
+	}{
+		{`"2018-08-26T16:11:12Z"`, time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)},
+		{`"2018-08-26T16:11:12.123Z"`, time.Date(2018, 8, 26, 16, 11, 12, 123000000, time.UTC)},
+		{`"2018-08-26T18:11:12+02:00"`, time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)},
+		{`"2018-08-26T18:11:12.5+0200"`, time.Date(2018, 8, 26, 16, 11, 12, 500000000, time.UTC)},
+		{`"2018-08-26T16:11:12"`, time.Date(2018, 8, 26, 16, 11, 12, 0, time.UTC)},
+		{`"2018-08-26T16:11:12.1234567"`, time.Date(2018, 8, 26, 16, 11, 12, 123456700, time.UTC)},
+		{`"2018-08-26"`, time.Date(2018, 8, 26, 0, 0, 0, 0, time.UTC)},
+		{`""`, time.Time{}},
+		{`null`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(tt.data), &ts); err != nil {
+			t.Errorf("Timestamp.UnmarshalJSON(%s) returned error: %v", tt.data, err)
+			continue
+		}
+		if !ts.Equal(tt.expected) {
+			t.Errorf("Timestamp.UnmarshalJSON(%s) = %v, expected %v", tt.data, ts, tt.expected)
+		}
+	}
+
+	for _, data := range []string{`"yesterday"`, `"26/08/2018"`, `1535300000`} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(data), &ts); err == nil {
+			t.Errorf("Timestamp.UnmarshalJSON(%s) expected error", data)
+		}
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		ts       Timestamp
+		expected string
+	}{
+		{testTimestamp, `"2018-08-26T16:11:12Z"`},
+		{Timestamp{time.Date(2018, 8, 26, 18, 11, 12, 500000000, time.FixedZone("", 2*60*60))}, `"2018-08-26T18:11:12.5+02:00"`},
+		{Timestamp{}, `null`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.ts)
+		if err != nil {
+			t.Errorf("Timestamp.MarshalJSON(%v) returned error: %v", tt.ts, err)
+		}
+		if string(data) != tt.expected {
+			t.Errorf("Timestamp.MarshalJSON(%v) = %s, expected %s", tt.ts, data, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package dome9
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const usersBasePath = "v2/user"
+
+// UsersService resource has methods to manage the users of a Dome9 account,
+// their roles and permissions.
+// See: https://api-v2-docs.dome9.com/#Dome9-API-Users
+type UsersService interface {
+	List(context.Context) ([]User, *http.Response, error)
+	Get(context.Context, int64) (*User, *http.Response, error)
+	Create(context.Context, *UserCreateRequest) (*User, *http.Response, error)
+	Update(context.Context, int64, *UserUpdateRequest) (*User, *http.Response, error)
+	Delete(context.Context, int64) (*http.Response, error)
+	SetOwner(context.Context, int64) (*http.Response, error)
+	SetSSO(context.Context, int64, bool) (*http.Response, error)
+	SetMFA(context.Context, int64, bool) (*http.Response, error)
+}
+
+// UsersServiceOp handles communication with the Users
+// related methods of the Dome9 API.
+type UsersServiceOp struct {
+	client *Client
+}
+
+var _ UsersService = &UsersServiceOp{}
+
+// User is a user of a Dome9 account.
+type User struct {
+	ID           int64        `json:"id"`
+	Name         string       `json:"name"`
+	IsSuperUser  bool         `json:"isSuperUser"`
+	IsAuditor    bool         `json:"isAuditor"`
+	IsOwner      bool         `json:"isOwner"`
+	HasAPIKey    bool         `json:"hasApiKey"`
+	SsoEnabled   bool         `json:"ssoEnabled"`
+	IsMfaEnabled bool         `json:"isMfaEnabled"`
+	RoleIDs      []int64      `json:"roleIds"`
+	Permissions  *Permissions `json:"permissions"`
+	LastLogin    Timestamp    `json:"lastLogin"`
+	DateCreated  Timestamp    `json:"dateCreated"`
+}
+
+// UserCreateRequest is used to create (invite) a user.
+type UserCreateRequest struct {
+	Email      string `json:"email"`
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	SsoEnabled bool   `json:"ssoEnabled"`
+}
+
+// UserUpdateRequest is used to assign roles to a user and to set the
+// permissions granted to the user on top of the ones of its roles.
+type UserUpdateRequest struct {
+	RoleIDs     []int64      `json:"roleIds"`
+	Permissions *Permissions `json:"permissions"`
+}
+
+// userToggle is used to create the JSON object to enable or disable a user setting.
+type userToggle struct {
+	Enabled bool `json:"enabled"`
+}
+
+// List all users.
+func (s *UsersServiceOp) List(ctx context.Context) ([]User, *http.Response, error) {
+	path := usersBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []User
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, err
+}
+
+// Get a user by its ID.
+func (s *UsersServiceOp) Get(ctx context.Context, userID int64) (*User, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", usersBasePath, userID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// Create (invite) a user.
+func (s *UsersServiceOp) Create(ctx context.Context, createRequest *UserCreateRequest) (*User, *http.Response, error) {
+	path := usersBasePath
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// Update the roles and permissions of a user. A nil or empty RoleIDs removes
+// all the roles of the user.
+func (s *UsersServiceOp) Update(ctx context.Context, userID int64, updateRequest *UserUpdateRequest) (*User, *http.Response, error) {
+	path := fmt.Sprintf("%s/%d", usersBasePath, userID)
+
+	if updateRequest != nil && updateRequest.RoleIDs == nil {
+		r := *updateRequest
+		r.RoleIDs = []int64{}
+		updateRequest = &r
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// Delete a user.
+func (s *UsersServiceOp) Delete(ctx context.Context, userID int64) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d", usersBasePath, userID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	// Delete returns a 204 No Content.
+	// Error on anything else.
+	if resp.StatusCode != 204 {
+		return resp, fmt.Errorf("Expected Status Code 204. Got: %v", resp.StatusCode)
+	}
+
+	return resp, err
+}
+
+// SetOwner makes a user the owner of the Dome9 account.
+func (s *UsersServiceOp) SetOwner(ctx context.Context, userID int64) (*http.Response, error) {
+	path := fmt.Sprintf("%s/ownership/%d", usersBasePath, userID)
+
+	return s.put(ctx, path, nil)
+}
+
+// SetSSO enables or disables single sign-on for a user.
+func (s *UsersServiceOp) SetSSO(ctx context.Context, userID int64, enabled bool) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d/sso", usersBasePath, userID)
+
+	return s.put(ctx, path, userToggle{Enabled: enabled})
+}
+
+// SetMFA enables or disables multi-factor authentication for a user.
+func (s *UsersServiceOp) SetMFA(ctx context.Context, userID int64, enabled bool) (*http.Response, error) {
+	path := fmt.Sprintf("%s/%d/mfa", usersBasePath, userID)
+
+	return s.put(ctx, path, userToggle{Enabled: enabled})
+}
+
+func (s *UsersServiceOp) put(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
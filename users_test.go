@@ -0,0 +1,192 @@
+package dome9
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsers_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[
+  {
+    "id": 42,
+    "name": "user@example.com",
+    "isSuperUser": false,
+    "isAuditor": false,
+    "isOwner": false,
+    "hasApiKey": true,
+    "ssoEnabled": true,
+    "isMfaEnabled": false,
+    "roleIds": [1337],
+    "permissions": {
+      "access": [],
+      "view": [""],
+      "manage": [],
+      "create": []
+    },
+    "lastLogin": "2018-08-26T16:11:12Z",
+    "dateCreated": "2018-08-26T16:11:12Z"
+  }
+]`)
+	})
+
+	users, _, err := client.Users.List(ctx)
+	if err != nil {
+		t.Errorf("Users.List returned error: %v", err)
+	}
+
+	expected := []User{{
+		ID:          42,
+		Name:        "user@example.com",
+		HasAPIKey:   true,
+		SsoEnabled:  true,
+		RoleIDs:     []int64{1337},
+		Permissions: &Permissions{Access: []string{}, View: []string{""}, Manage: []string{}, Create: []string{}},
+		LastLogin:   testTimestamp,
+		DateCreated: testTimestamp,
+	}}
+
+	if !reflect.DeepEqual(users, expected) {
+		t.Errorf("Users.List\n got=%#v\nwant=%#v", users, expected)
+	}
+}
+
+func TestUsers_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+  "id": 42,
+  "name": "user@example.com",
+  "ssoEnabled": true,
+  "roleIds": [1337]
+}`)
+	})
+
+	user, _, err := client.Users.Get(ctx, 42)
+	if err != nil {
+		t.Errorf("Users.Get returned error: %v", err)
+	}
+
+	if expected := (&User{ID: 42, Name: "user@example.com", SsoEnabled: true, RoleIDs: []int64{1337}}); !reflect.DeepEqual(user, expected) {
+		t.Errorf("Users.Get\n got=%#v\nwant=%#v", user, expected)
+	}
+}
+
+func TestUsers_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"email":"user@example.com","firstName":"First","lastName":"Last","ssoEnabled":true}`)
+		fmt.Fprint(w, `{"id": 42, "name": "user@example.com", "ssoEnabled": true, "roleIds": []}`)
+	})
+
+	createRequest := &UserCreateRequest{Email: "user@example.com", FirstName: "First", LastName: "Last", SsoEnabled: true}
+
+	user, _, err := client.Users.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Users.Create returned error: %v", err)
+	}
+
+	if expected := (&User{ID: 42, Name: "user@example.com", SsoEnabled: true, RoleIDs: []int64{}}); !reflect.DeepEqual(user, expected) {
+		t.Errorf("Users.Create\n got=%#v\nwant=%#v", user, expected)
+	}
+}
+
+func TestUsers_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"roleIds":[1337],"permissions":null}`)
+		fmt.Fprint(w, `{"id": 42, "roleIds": [1337]}`)
+	})
+
+	_, _, err := client.Users.Update(ctx, 42, &UserUpdateRequest{RoleIDs: []int64{1337}})
+	if err != nil {
+		t.Errorf("Users.Update returned error: %v", err)
+	}
+}
+
+func TestUsers_Update_noRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"roleIds":[],"permissions":null}`)
+		fmt.Fprint(w, `{"id": 42, "roleIds": []}`)
+	})
+
+	for _, roleIDs := range [][]int64{{}, nil} {
+		updateRequest := &UserUpdateRequest{RoleIDs: roleIDs}
+		_, _, err := client.Users.Update(ctx, 42, updateRequest)
+		if err != nil {
+			t.Errorf("Users.Update returned error: %v", err)
+		}
+		if roleIDs == nil && updateRequest.RoleIDs != nil {
+			t.Errorf("Users.Update modified the update request")
+		}
+	}
+}
+
+func TestUsers_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.Delete(ctx, 42)
+	if err != nil {
+		t.Errorf("Users.Delete returned error: %v", err)
+	}
+}
+
+func TestUsers_SetOwner(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/ownership/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+	})
+
+	_, err := client.Users.SetOwner(ctx, 42)
+	if err != nil {
+		t.Errorf("Users.SetOwner returned error: %v", err)
+	}
+}
+
+func TestUsers_SetSSOAndMFA(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/user/42/sso", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"enabled":true}`)
+	})
+	mux.HandleFunc("/v2/user/42/mfa", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"enabled":false}`)
+	})
+
+	if _, err := client.Users.SetSSO(ctx, 42, true); err != nil {
+		t.Errorf("Users.SetSSO returned error: %v", err)
+	}
+	if _, err := client.Users.SetMFA(ctx, 42, false); err != nil {
+		t.Errorf("Users.SetMFA returned error: %v", err)
+	}
+}